@@ -79,6 +79,10 @@ func NewPocketCoreApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.
 	// add the keybase to the pocket core keeper
 	app.pocketKeeper.Keybase = MustGetKeybase()
 	app.pocketKeeper.TmNode = getTMClient()
+	// add the opt-in node agent to the pocket core keeper
+	app.pocketKeeper.AutoUnjail = autoUnjail
+	app.pocketKeeper.AutoCompound = autoCompound
+	app.pocketKeeper.CompoundReserve = sdk.NewInt(compoundReserve)
 	// setup module manager
 	app.mm = module.NewManager(
 		auth.NewAppModule(app.accountKeeper),
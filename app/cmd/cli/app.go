@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"github.com/pokt-network/pocket-core/app"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	"github.com/pokt-network/posmint/types"
 	"github.com/spf13/cobra"
 	"log"
@@ -16,6 +17,8 @@ func init() {
 	rootCmd.AddCommand(appCmd)
 	appCmd.AddCommand(appStakeCmd)
 	appCmd.AddCommand(appUnstakeCmd)
+	appCmd.AddCommand(appPartialUnstakeCmd)
+	appCmd.AddCommand(appExcludeNodesCmd)
 	appCmd.AddCommand(createAATCmd)
 }
 
@@ -25,10 +28,36 @@ var appCmd = &cobra.Command{
 	Long:  ``,
 }
 
+var chainWeights string
+
+func init() {
+	appStakeCmd.Flags().StringVar(&chainWeights, "chain-weights", "", "a comma separated list of <chain>:<weight>, the share of the max relays of each chain (evenly split by default)")
+}
+
+// parse the chain weights in the form <chain>:<weight>,<chain>:<weight>
+func parseChainWeights(raw string) ([]appsTypes.ChainWeight, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	cws := make([]appsTypes.ChainWeight, 0)
+	for _, pair := range strings.Split(raw, ",") {
+		s := strings.Split(strings.TrimSpace(pair), ":")
+		if len(s) != 2 {
+			return nil, fmt.Errorf("invalid chain weight %s, must be <chain>:<weight>", pair)
+		}
+		weight, err := strconv.ParseInt(s[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chain weight %s: %s", pair, err)
+		}
+		cws = append(cws, appsTypes.ChainWeight{Chain: s[0], Weight: weight})
+	}
+	return cws, nil
+}
+
 var appStakeCmd = &cobra.Command{
 	Use:   "stake <fromAddr> <amount> <chains>",
 	Short: "Stake an app in the network",
-	Long:  `Stake the app into the network, making it have network throughput. Prompts the user for the <fromAddr> account passphrase.`,
+	Long:  `Stake the app into the network, making it have network throughput. The max relays are split between the chains by the optional --chain-weights, or evenly. A staked app may stake again to increase its stake and replace its chains at the next session. Prompts the user for the <fromAddr> account passphrase.`,
 	Args:  cobra.ExactArgs(3),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.SetTMNode(tmNode)
@@ -44,8 +73,13 @@ var appStakeCmd = &cobra.Command{
 		}
 		rawChains := reg.ReplaceAllString(args[2], "")
 		chains := strings.Split(rawChains, ",")
+		cws, err := parseChainWeights(chainWeights)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
 		fmt.Println("Enter Password: ")
-		res, err := app.StakeApp(chains, fromAddr, app.Credentials(), types.NewInt(int64(amount)))
+		res, err := app.StakeApp(chains, cws, fromAddr, app.Credentials(), types.NewInt(int64(amount)))
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -71,6 +105,51 @@ var appUnstakeCmd = &cobra.Command{
 	},
 }
 
+var appPartialUnstakeCmd = &cobra.Command{
+	Use:   "partial-unstake <fromAddr> <amount>",
+	Short: "Unstake part of the stake of an app in the network",
+	Long:  `Unstakes <amount> tokens of a staked app, which stays staked with the rest of its stake and less throughput. The stake left must be above the minimum stake and the tokens are released after the unstaking time. Prompts the user for the <fromAddr> account passphrase.`,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.SetTMNode(tmNode)
+		amount, err := strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := app.PartialUnstakeApp(args[0], app.Credentials(), types.NewInt(int64(amount)))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
+	},
+}
+
+var appExcludeNodesCmd = &cobra.Command{
+	Use:   "exclude-nodes <fromAddr> <nodeAddrs>",
+	Short: "Exclude nodes from the sessions of an app",
+	Long:  `Replaces the nodes excluded from the sessions of a staked app with the comma separated <nodeAddrs>, applied at the next session. An excluded node is only selected when not enough other nodes stake the chain. Omitting <nodeAddrs> removes the exclusions. Prompts the user for the <fromAddr> account passphrase.`,
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.SetTMNode(tmNode)
+		nodeAddrs := make([]string, 0)
+		if len(args) == 2 {
+			for _, addr := range strings.Split(args[1], ",") {
+				nodeAddrs = append(nodeAddrs, strings.TrimSpace(addr))
+			}
+		}
+		fmt.Println("Enter Password: ")
+		res, err := app.ExcludeNodesApp(args[0], nodeAddrs, app.Credentials())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
+	},
+}
+
 var createAATCmd = &cobra.Command{
 	Use:   "create-aat <appAddr> <clientPubKey>",
 	Short: "Creates an application authentication token",
@@ -98,6 +177,10 @@ NOTE: USE THIS METHOD AT YOUR OWN RISK. READ THE APPLICATION SECURITY GUIDELINES
 		}
 		fmt.Println("Enter Password: ")
 		aatBytes, err := app.GenerateAAT(hex.EncodeToString(res.PublicKey.RawBytes()), args[1], app.Credentials())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
 		fmt.Println(string(aatBytes))
 	},
 }
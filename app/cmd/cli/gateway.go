@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"fmt"
+	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/app/cmd/gateway"
+	"github.com/spf13/cobra"
+)
+
+var gatewayPort string
+
+func init() {
+	rootCmd.AddCommand(gatewayCmd)
+	gatewayCmd.AddCommand(gatewayStartCmd)
+	gatewayStartCmd.Flags().StringVar(&gatewayPort, "gatewayPort", "8082", "the port for the gateway relay endpoints")
+}
+
+var gatewayCmd = &cobra.Command{
+	Use:   "gateway",
+	Short: "application gateway",
+	Long:  ``,
+}
+
+var gatewayStartCmd = &cobra.Command{
+	Use:   "start <appAddr>",
+	Short: "Start a relay gateway for an application",
+	Long: `Starts a gateway that relays plain HTTP requests to the Pocket network on behalf of the <appAddr> application of the keybase.
+The gateway mints an AAT for its own client key and serves an endpoint per chain: POST /relay/<chainHash>, the request body is relayed to a node of the current session and the upstream response body is returned.
+The session of each chain is refreshed at every session block through the --node endpoint. The nodes of the session are scored by latency, error rate and response signature validity:
+the relays go to the best node, failing nodes are demoted, failed relays are retried on another node and the scores are exposed as Prometheus metrics at GET /metrics.
+Prompts the user for the <appAddr> account passphrase.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.SetTMNode(tmNode)
+		app.InitDataDirectory(datadir)
+		fmt.Println("Enter Password: ")
+		g, err := gateway.NewGateway(args[0], app.Credentials())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Gateway listening on port %s\n", gatewayPort)
+		g.Start(gatewayPort)
+	},
+}
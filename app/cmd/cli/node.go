@@ -15,7 +15,14 @@ func init() {
 	rootCmd.AddCommand(nodesCmd)
 	nodesCmd.AddCommand(nodeStakeCmd)
 	nodesCmd.AddCommand(nodeUnstakeCmd)
+	nodesCmd.AddCommand(nodePartialUnstakeCmd)
 	nodesCmd.AddCommand(nodeUnjailCmd)
+	nodesCmd.AddCommand(nodeChangeOutputCmd)
+	nodesCmd.AddCommand(nodeRotateKeyCmd)
+	nodesCmd.AddCommand(nodeDelegateCmd)
+	nodesCmd.AddCommand(nodeUndelegateCmd)
+	nodesCmd.AddCommand(nodeRedelegateCmd)
+	nodesCmd.AddCommand(nodeSetCommissionCmd)
 }
 
 var nodesCmd = &cobra.Command{
@@ -25,10 +32,10 @@ var nodesCmd = &cobra.Command{
 }
 
 var nodeStakeCmd = &cobra.Command{
-	Use:   "stake <fromAddr> <amount> <chains> <serviceURI>",
+	Use:   "stake <fromAddr> <amount> <chains> <serviceURI> [outputAddr]",
 	Short: "Stake a node in the network",
-	Long:  `Stake the node into the network, making it available for service. Prompts the user for the <fromAddr> account passphrase.`,
-	Args:  cobra.ExactArgs(4),
+	Long:  `Stake the node into the network, making it available for service. If the node is already staked, the stake (which may only increase), chains and serviceURI are edited and take effect at the next session. The optional [outputAddr] receives the rewards and unstaked tokens of the node. Prompts the user for the <fromAddr> account passphrase.`,
+	Args:  cobra.RangeArgs(4, 5),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.SetTMNode(tmNode)
 		fromAddr := args[0]
@@ -44,8 +51,12 @@ var nodeStakeCmd = &cobra.Command{
 		rawChains := reg.ReplaceAllString(args[2], "")
 		chains := strings.Split(rawChains, ",")
 		serviceURI := args[3]
+		var outputAddr string
+		if len(args) == 5 {
+			outputAddr = args[4]
+		}
 		fmt.Println("Enter Password: ")
-		res, err := app.StakeNode(chains, serviceURI, fromAddr, app.Credentials(), types.NewInt(int64(amount)))
+		res, err := app.StakeNode(chains, serviceURI, fromAddr, outputAddr, app.Credentials(), types.NewInt(int64(amount)))
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -55,14 +66,44 @@ var nodeStakeCmd = &cobra.Command{
 }
 
 var nodeUnstakeCmd = &cobra.Command{
-	Use:   "unstake <fromAddr>",
+	Use:   "unstake <fromAddr> [nodeAddr]",
 	Short: "Unstake a node in the network",
-	Long:  `Unstake a node from the network, changing it's status to Unstaking. Prompts the user for the <fromAddr> account passphrase.`,
-	Args:  cobra.ExactArgs(1),
+	Long:  `Unstake a node from the network, changing it's status to Unstaking. If the node has an output address, <fromAddr> must be the output address and [nodeAddr] the address of the node. Prompts the user for the <fromAddr> account passphrase.`,
+	Args:  cobra.RangeArgs(1, 2),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.SetTMNode(tmNode)
+		nodeAddr := args[0]
+		if len(args) == 2 {
+			nodeAddr = args[1]
+		}
 		fmt.Println("Enter Password: ")
-		res, err := app.UnstakeNode(args[0], app.Credentials())
+		res, err := app.UnstakeNode(nodeAddr, args[0], app.Credentials())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
+	},
+}
+
+var nodePartialUnstakeCmd = &cobra.Command{
+	Use:   "partial-unstake <fromAddr> <amount> [nodeAddr]",
+	Short: "Unstake part of the stake of a node in the network",
+	Long:  `Unstakes <amount> tokens of a staked node, which stays staked with the rest of its stake. The stake left must be above the minimum stake and the tokens are released after the unstaking time. If the node has an output address, <fromAddr> must be the output address and [nodeAddr] the address of the node. Prompts the user for the <fromAddr> account passphrase.`,
+	Args:  cobra.RangeArgs(2, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.SetTMNode(tmNode)
+		nodeAddr := args[0]
+		if len(args) == 3 {
+			nodeAddr = args[2]
+		}
+		amount, err := strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := app.PartialUnstakeNode(nodeAddr, args[0], app.Credentials(), types.NewInt(int64(amount)))
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -87,3 +128,136 @@ var nodeUnjailCmd = &cobra.Command{
 		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
 	},
 }
+
+var nodeChangeOutputCmd = &cobra.Command{
+	Use:   "change-output <fromAddr> <nodeAddr> <outputAddr>",
+	Short: "Changes the output address of a node in the network",
+	Long:  `Changes the address that receives the rewards and unstaked tokens of <nodeAddr> to <outputAddr>. Once an output address is set, <fromAddr> must be the current output address. Prompts the user for the <fromAddr> account passphrase.`,
+	Args:  cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.SetTMNode(tmNode)
+		fmt.Println("Enter Password: ")
+		res, err := app.ChangeNodeOutput(args[1], args[2], args[0], app.Credentials())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
+	},
+}
+
+var nodeRotateKeyCmd = &cobra.Command{
+	Use:   "rotate-key <fromAddr> <newKeyAddr> [nodeAddr]",
+	Short: "Rotates the consensus key of a node in the network",
+	Long:  `Replaces the consensus key of the node with the public key of the keybase account <newKeyAddr>, keeping its address, stake and rewards. If the node has an output address, <fromAddr> must be the output address and [nodeAddr] the address of the node. Prompts the user for the <fromAddr> account passphrase, then for the <newKeyAddr> account passphrase to make it the coinbase and the validator key file of this node. The node must be restarted to sign blocks and relays with the new key.`,
+	Args:  cobra.RangeArgs(2, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.SetTMNode(tmNode)
+		nodeAddr := args[0]
+		if len(args) == 3 {
+			nodeAddr = args[2]
+		}
+		fmt.Println("Enter Password: ")
+		res, err := app.RotateNodeConsensusKey(nodeAddr, args[1], args[0], app.Credentials())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
+		fmt.Println("Enter the passphrase of the new key: ")
+		err = app.SetConsensusKey(args[1], app.Credentials())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Restart the node to use the new consensus key")
+	},
+}
+
+var nodeDelegateCmd = &cobra.Command{
+	Use:   "delegate <fromAddr> <nodeAddr> <amount>",
+	Short: "Delegate tokens to a node in the network",
+	Long:  `Delegates <amount> tokens of <fromAddr> to the staked node <nodeAddr>. The delegated tokens earn a share of the node's relay rewards minus the node commission and are slashed together with the node. Prompts the user for the <fromAddr> account passphrase.`,
+	Args:  cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.SetTMNode(tmNode)
+		amount, err := strconv.Atoi(args[2])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := app.DelegateToNode(args[0], args[1], app.Credentials(), types.NewInt(int64(amount)))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
+	},
+}
+
+var nodeUndelegateCmd = &cobra.Command{
+	Use:   "undelegate <fromAddr> <nodeAddr> <amount>",
+	Short: "Undelegate tokens from a node in the network",
+	Long:  `Undelegates <amount> tokens of <fromAddr> from the node <nodeAddr>. The tokens are returned to <fromAddr> after the unstaking time. Prompts the user for the <fromAddr> account passphrase.`,
+	Args:  cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.SetTMNode(tmNode)
+		amount, err := strconv.Atoi(args[2])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := app.UndelegateFromNode(args[0], args[1], app.Credentials(), types.NewInt(int64(amount)))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
+	},
+}
+
+var nodeRedelegateCmd = &cobra.Command{
+	Use:   "redelegate <fromAddr> <srcNodeAddr> <dstNodeAddr> <amount>",
+	Short: "Move delegated tokens from one node to another",
+	Long:  `Moves <amount> tokens of <fromAddr> delegated to <srcNodeAddr> to the staked node <dstNodeAddr> without waiting for the unstaking time. Prompts the user for the <fromAddr> account passphrase.`,
+	Args:  cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.SetTMNode(tmNode)
+		amount, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := app.RedelegateNode(args[0], args[1], args[2], app.Credentials(), types.NewInt(int64(amount)))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
+	},
+}
+
+var nodeSetCommissionCmd = &cobra.Command{
+	Use:   "set-commission <fromAddr> <nodeAddr> <rate>",
+	Short: "Sets the commission rate of a node in the network",
+	Long:  `Sets the rate (between 0 and 1) of the delegators relay rewards kept by <nodeAddr>. <fromAddr> must be the output address of the node, or the node itself if no output address is set. Prompts the user for the <fromAddr> account passphrase.`,
+	Args:  cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.SetTMNode(tmNode)
+		commission, er := types.NewDecFromStr(args[2])
+		if er != nil {
+			fmt.Println(er)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := app.SetNodeCommission(args[1], args[0], app.Credentials(), commission)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
+	},
+}
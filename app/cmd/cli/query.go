@@ -1,9 +1,9 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/pokt-network/pocket-core/app"
 	appTypes "github.com/pokt-network/pocket-core/x/apps/types"
@@ -20,12 +20,19 @@ func init() {
 	queryCmd.AddCommand(queryBalance)
 	queryCmd.AddCommand(queryAccount)
 	queryCmd.AddCommand(queryNode)
+	queryCmd.AddCommand(queryDelegations)
+	queryCmd.AddCommand(queryNodeSlashes)
+	queryCmd.AddCommand(queryNodeEarnings)
+	queryCmd.AddCommand(queryNodeUnstaking)
 	queryCmd.AddCommand(queryApps)
 	queryCmd.AddCommand(queryApp)
+	queryCmd.AddCommand(queryAppUsage)
+	queryCmd.AddCommand(queryAppUnstaking)
 	queryCmd.AddCommand(queryNodeParams)
 	queryCmd.AddCommand(queryAppParams)
 	queryCmd.AddCommand(queryNodeReceipts)
 	queryCmd.AddCommand(queryNodeReceipt)
+	queryCmd.AddCommand(querySession)
 	queryCmd.AddCommand(queryPocketParams)
 	queryCmd.AddCommand(queryPocketSupportedChains)
 	queryCmd.AddCommand(querySupply)
@@ -155,14 +162,34 @@ var queryAccount = &cobra.Command{
 
 var nodeStakingStatus string
 
+// the filters of the nodes and apps queries
+var (
+	queryJailedStatus string
+	queryBlockchain   string
+	queryMinimumStake int64
+	querySortBy       string
+	queryPage         int
+	queryLimit        int
+)
+
 func init() {
 	queryNodes.Flags().StringVar(&nodeStakingStatus, "staking-status", "", "the staking status of the node")
+	addQueryFilterFlags(queryNodes)
+}
+
+func addQueryFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&queryJailedStatus, "jailed-status", "", "the jailed status: jailed or unjailed")
+	cmd.Flags().StringVar(&queryBlockchain, "blockchain", "", "the hash of a staked chain")
+	cmd.Flags().Int64Var(&queryMinimumStake, "min-stake", 0, "the minimum staked tokens")
+	cmd.Flags().StringVar(&querySortBy, "sort", "", "the sort order: address or tokens (highest first)")
+	cmd.Flags().IntVar(&queryPage, "page", 1, "the page of the results")
+	cmd.Flags().IntVar(&queryLimit, "limit", 0, "the number of results per page, the maximum by default")
 }
 
 var queryNodes = &cobra.Command{
-	Use:   "nodes --staking-status=<nodeStakingStatus> <height>",
+	Use:   "nodes --staking-status=<nodeStakingStatus> --jailed-status=<jailedStatus> --blockchain=<chain> --min-stake=<amount> --sort=<sortBy> --page=<page> --limit=<limit> <height>",
 	Short: "Gets nodes",
-	Long:  `Returns the list of all nodes known at the specified <height>.`,
+	Long:  `Returns the page of the nodes known at the specified <height> matching the filters.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		app.SetTMNode(tmNode)
 		var height int
@@ -176,25 +203,20 @@ var queryNodes = &cobra.Command{
 				return
 			}
 		}
-		var res nodeTypes.Validators
-		var err error
-		switch strings.ToLower(nodeStakingStatus) {
-		case "":
-			// no status passed
-			res, err = app.QueryAllNodes(int64(height))
-		case "staked":
-			// staked nodes
-			res, err = app.QueryStakedNodes(int64(height))
-		case "unstaked":
-			// unstaked nodes
-			res, err = app.QueryUnstakedNodes(int64(height))
-		case "unstaking":
-			// unstaking nodes
-			res, err = app.QueryUnstakingNodes(int64(height))
-		default:
-			fmt.Println("invalid staking status, can be staked, unstaked, unstaking, or empty")
+		opts := nodeTypes.QueryValidatorsParams{
+			Page:          queryPage,
+			Limit:         queryLimit,
+			StakingStatus: nodeStakingStatus,
+			JailedStatus:  queryJailedStatus,
+			Blockchain:    queryBlockchain,
+			MinimumStake:  queryMinimumStake,
+			SortBy:        querySortBy,
+		}
+		if err := opts.Validate(); err != nil {
+			fmt.Println(err)
 			return
 		}
+		res, err := app.QueryNodes(int64(height), opts)
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -234,6 +256,136 @@ var queryNode = &cobra.Command{
 	},
 }
 
+var queryDelegations = &cobra.Command{
+	Use:   "delegations <delegatorAddr> <height>",
+	Short: "Gets the delegations of an address",
+	Args:  cobra.MinimumNArgs(1),
+	Long:  `Returns the delegations and the unstaking delegations of <delegatorAddr> at the specified <height>.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.SetTMNode(tmNode)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		delegations, err := app.QueryDelegations(args[0], int64(height))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		unstakingDelegations, err := app.QueryUnstakingDelegations(args[0], int64(height))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Delegations")
+		for _, d := range delegations {
+			fmt.Printf("%s\n\n", d.String())
+		}
+		fmt.Println("Unstaking Delegations")
+		for _, ud := range unstakingDelegations {
+			fmt.Printf("%s\n\n", ud.String())
+		}
+	},
+}
+
+var queryNodeSlashes = &cobra.Command{
+	Use:   "node-slashes <address> <height>",
+	Short: "Gets the slash history of a node",
+	Args:  cobra.MinimumNArgs(1),
+	Long:  `Returns the slashes and burns of the node <address> up to the specified <height>, with the reason, the tokens burned and the stake left.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.SetTMNode(tmNode)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		records, err := app.QueryNodeSlashes(args[0], int64(height))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		for _, r := range records {
+			fmt.Printf("%s\n\n", r.String())
+		}
+	},
+}
+
+var queryNodeEarnings = &cobra.Command{
+	Use:   "node-earnings <address> <height>",
+	Short: "Gets the earnings of a node",
+	Args:  cobra.MinimumNArgs(1),
+	Long:  `Returns the relay and proposer rewards minted to the node <address> within the earnings retention before the specified <height>, and the relay reward and burns pending for the next block.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.SetTMNode(tmNode)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		earnings, err := app.QueryNodeEarnings(args[0], int64(height))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(earnings.String())
+	},
+}
+
+var unstakingAddress string
+
+func init() {
+	queryNodeUnstaking.Flags().StringVar(&unstakingAddress, "address", "", "the address of a node")
+	queryAppUnstaking.Flags().StringVar(&unstakingAddress, "address", "", "the address of an app")
+}
+
+var queryNodeUnstaking = &cobra.Command{
+	Use:   "node-unstaking --address=<address> <height>",
+	Short: "Gets the unstaking schedule of nodes",
+	Long:  `Returns the pending unstakes and partial unstakes of the node --address (or of all the nodes when omitted) at the specified <height>, with the amount and the completion time of each.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.SetTMNode(tmNode)
+		var height int
+		if len(args) == 0 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		entries, err := app.QueryNodeUnstaking(unstakingAddress, int64(height))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		for _, e := range entries {
+			fmt.Printf("%s\n\n", e.String())
+		}
+	},
+}
+
 var queryNodeParams = &cobra.Command{
 	Use:   "node-params <height>",
 	Short: "Gets node parameters",
@@ -263,13 +415,14 @@ var queryNodeParams = &cobra.Command{
 var appStakingStatus string
 
 func init() {
-	queryApps.Flags().StringVar(&nodeStakingStatus, "staking-status", "", "the staking status of the node")
+	queryApps.Flags().StringVar(&appStakingStatus, "staking-status", "", "the staking status of the app")
+	addQueryFilterFlags(queryApps)
 }
 
 var queryApps = &cobra.Command{
-	Use:   "apps --staking-status=<nodeStakingStatus> <height>",
+	Use:   "apps --staking-status=<appStakingStatus> --jailed-status=<jailedStatus> --blockchain=<chain> --min-stake=<amount> --sort=<sortBy> --page=<page> --limit=<limit> <height>",
 	Short: "Gets apps",
-	Long:  `Returns the list of all applications known at the specified <height>`,
+	Long:  `Returns the page of the applications known at the specified <height> matching the filters.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		app.SetTMNode(tmNode)
 		var height int
@@ -283,25 +436,20 @@ var queryApps = &cobra.Command{
 				return
 			}
 		}
-		var res appTypes.Applications
-		var err error
-		switch strings.ToLower(appStakingStatus) {
-		case "":
-			// no status passed
-			res, err = app.QueryAllApps(int64(height))
-		case "staked":
-			// staked nodes
-			res, err = app.QueryStakedApps(int64(height))
-		case "unstaked":
-			// unstaked nodes
-			res, err = app.QueryUnstakedApps(int64(height))
-		case "unstaking":
-			// unstaking nodes
-			res, err = app.QueryUnstakingApps(int64(height))
-		default:
-			fmt.Printf("invalid staking status, can be staked, unstaked, unstaking, or empty")
+		opts := appTypes.QueryAppsParams{
+			Page:          queryPage,
+			Limit:         queryLimit,
+			StakingStatus: appStakingStatus,
+			JailedStatus:  queryJailedStatus,
+			Blockchain:    queryBlockchain,
+			MinimumStake:  queryMinimumStake,
+			SortBy:        querySortBy,
+		}
+		if err := opts.Validate(); err != nil {
+			fmt.Println(err)
 			return
 		}
+		res, err := app.QueryApps(int64(height), opts)
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -341,6 +489,67 @@ var queryApp = &cobra.Command{
 	},
 }
 
+var appUsageBlockchain string
+
+func init() {
+	queryAppUsage.Flags().StringVar(&appUsageBlockchain, "blockchain", "", "the hash of a staked chain")
+}
+
+var queryAppUsage = &cobra.Command{
+	Use:   "app-usage --blockchain=<chain> <address> <height>",
+	Short: "Gets the relay usage of an app",
+	Args:  cobra.MinimumNArgs(1),
+	Long:  `Returns the relays claimed and proven for the app <address> against its max relays, per session and per chain, within the usage retention before the specified <height>.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.SetTMNode(tmNode)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		res, err := app.QueryAppUsage(args[0], appUsageBlockchain, int64(height))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res.String())
+	},
+}
+
+var queryAppUnstaking = &cobra.Command{
+	Use:   "app-unstaking --address=<address> <height>",
+	Short: "Gets the unstaking schedule of apps",
+	Long:  `Returns the pending unstakes and partial unstakes of the app --address (or of all the apps when omitted) at the specified <height>, with the amount and the completion time of each.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.SetTMNode(tmNode)
+		var height int
+		if len(args) == 0 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		entries, err := app.QueryAppUnstaking(unstakingAddress, int64(height))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		for _, e := range entries {
+			fmt.Printf("%s\n\n", e.String())
+		}
+	},
+}
+
 var queryAppParams = &cobra.Command{
 	Use:   "app-params <height>",
 	Short: "Gets app parameters",
@@ -428,6 +637,38 @@ var queryNodeReceipt = &cobra.Command{
 	},
 }
 
+var querySession = &cobra.Command{
+	Use:   "session <appPubKey> <networkId> <sessionHeight>",
+	Short: "Gets the session nodes",
+	Args:  cobra.MinimumNArgs(2),
+	Long:  `Returns the session of the app for the network at the specified <sessionHeight>, the latest session if no height is specified. Heights beyond the current session are rejected.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.SetTMNode(tmNode)
+		var sessionHeight int
+		if len(args) == 2 {
+			sessionHeight = 0 // latest
+		} else {
+			var err error
+			sessionHeight, err = strconv.Atoi(args[2])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		res, err := app.QuerySession(args[0], args[1], int64(sessionHeight))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.MarshalIndent(res, "", "  ")
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(string(j))
+	},
+}
+
 var queryPocketParams = &cobra.Command{
 	Use:   "pocket-params <height>",
 	Short: "Gets pocket parameters",
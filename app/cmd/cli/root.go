@@ -22,6 +22,9 @@ var (
 	pocketRPCPort   string
 	blockTime       int
 	testnet         bool
+	autoUnjail      bool
+	autoCompound    bool
+	compoundReserve int64
 )
 
 var CLIVersion = fmt.Sprintf("%s", app.AppVersion)
@@ -57,6 +60,9 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&pocketRPCPort, "pocketRPCPort", "8081", "the port for pocket rpc")
 	rootCmd.PersistentFlags().IntVar(&blockTime, "blockTime", 1, "how often should the network create blocks")
 	rootCmd.PersistentFlags().BoolVar(&testnet, "testnet", false, "would you like to connect to Pocket Network testnet")
+	startCmd.Flags().BoolVar(&autoUnjail, "autoUnjail", false, "automatically unjail the node once the jail duration is over")
+	startCmd.Flags().BoolVar(&autoCompound, "autoCompound", false, "automatically restake the balance of the node above the compoundReserve, unless the rewards go to an output address")
+	startCmd.Flags().Int64Var(&compoundReserve, "compoundReserve", 0, "the balance of the node that is never restaked by autoCompound")
 	rootCmd.AddCommand(startCmd)
 	rootCmd.AddCommand(resetCmd)
 	rootCmd.AddCommand(version)
@@ -69,6 +75,7 @@ var startCmd = &cobra.Command{
 	Long:  `Starts the Pocket node, picks up the config from the assigned <datadir>`,
 	Run: func(cmd *cobra.Command, args []string) {
 		app.SetTMNode(tmNode)
+		app.SetNodeAgent(autoUnjail, autoCompound, compoundReserve)
 		go rpc.StartRPC(pocketRPCPort)
 		tmNode := app.InitApp(app.InitDataDirectory(datadir), tmNode, strings.ToLower(persistentPeers), strings.ToLower(seeds), tmRPCPort, tmPeersPort, blockTime)
 		// We trap kill signals (2,3,15,9)
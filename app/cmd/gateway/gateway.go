@@ -0,0 +1,274 @@
+package gateway
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/app/cmd/rpc"
+	nodeexported "github.com/pokt-network/pocket-core/x/nodes/exported"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/pokt-network/posmint/crypto"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	relayPath      = "/v1/client/relay"
+	maxRequestSize = 1048576
+	relayTimeout   = 30 * time.Second
+)
+
+// a gateway relays plain http requests to the pocket network on behalf of an application
+type Gateway struct {
+	appPubKey string                   // the public key of the application paying for the relays
+	clientKey crypto.PrivateKey        // the client key that signs the relays
+	aat       pocketTypes.AAT          // the token of the client, signed by the application
+	sessions  map[string]*chainSession // the current session of each chain
+	mu        sync.Mutex               // protects the sessions
+	client    *http.Client             // the client used to reach the session nodes
+	metrics   *Metrics                 // the servicer scores
+	// the chain queries, through the tendermint node of the app package
+	queryHeight           func() (int64, error)
+	queryDispatch         func(header pocketTypes.SessionHeader) (*pocketTypes.DispatchResponse, error)
+	querySessionFrequency func(height int64) (int64, error)
+}
+
+// the session of a chain and the scores of its servicers
+type chainSession struct {
+	pocketTypes.Session
+	frequency int64
+	scores    scoreboard
+}
+
+// is the session still current at the height?
+func (s *chainSession) isCurrent(height int64) bool {
+	return height < s.SessionBlockHeight+s.frequency
+}
+
+// create a gateway for the application of the keybase, the gateway mints an aat for its own client key
+func NewGateway(appAddr, passphrase string) (*Gateway, error) {
+	addr, err := sdk.AddressFromHex(appAddr)
+	if err != nil {
+		return nil, err
+	}
+	kp, err := app.MustGetKeybase().Get(addr)
+	if err != nil {
+		return nil, err
+	}
+	appPubKey := kp.PublicKey.RawString()
+	clientKey := crypto.GenerateEd25519PrivKey()
+	aatBytes, err := app.GenerateAAT(appPubKey, clientKey.PublicKey().RawString(), passphrase)
+	if err != nil {
+		return nil, err
+	}
+	var aat pocketTypes.AAT
+	if err := json.Unmarshal(aatBytes, &aat); err != nil {
+		return nil, err
+	}
+	return &Gateway{
+		appPubKey:     appPubKey,
+		clientKey:     clientKey,
+		aat:           aat,
+		sessions:      make(map[string]*chainSession),
+		client:        &http.Client{Timeout: relayTimeout},
+		metrics:       PrometheusMetrics("pocket"),
+		queryHeight:   app.QueryHeight,
+		queryDispatch: app.QueryDispatch,
+		querySessionFrequency: func(height int64) (int64, error) {
+			params, err := app.QueryNodeParams(height)
+			return params.SessionBlockFrequency, err
+		},
+	}, nil
+}
+
+// serve the gateway endpoints on the port
+func (g *Gateway) Start(port string) {
+	log.Fatal(http.ListenAndServe(":"+port, g.Router()))
+}
+
+// a relay endpoint per chain: /relay/<chainHash>, and the servicer scores: /metrics
+func (g *Gateway) Router() *httprouter.Router {
+	router := httprouter.New()
+	router.POST("/relay/:chain", g.Relay)
+	router.Handler("GET", "/metrics", promhttp.Handler())
+	return router
+}
+
+// relay the request body to a node of the chain session and answer with the upstream body
+func (g *Gateway) Relay(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+	if err != nil {
+		rpc.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	res, err := g.SendRelay(ps.ByName("chain"), string(body))
+	if err != nil {
+		rpc.WriteErrorResponse(w, http.StatusBadGateway, err.Error())
+		return
+	}
+	rpc.WriteRaw(w, res, r.URL.Path, r.Host)
+}
+
+// wrap the data into a relay signed by the client key, send it to the best session node and unwrap the response,
+// a failed relay is retried on the next best node of the session
+func (g *Gateway) SendRelay(chain, data string) (string, error) {
+	if err := pocketTypes.HashVerification(chain); err != nil {
+		return "", err
+	}
+	height, err := g.queryHeight()
+	if err != nil {
+		return "", err
+	}
+	session, err := g.session(chain, height)
+	if err != nil {
+		return "", err
+	}
+	// every node of the session is tried at most once
+	var lastErr error
+	tried := make(map[string]bool)
+	for range session.SessionNodes {
+		g.mu.Lock()
+		node, _ := session.scores.best(session.SessionNodes, tried)
+		g.mu.Unlock()
+		tried[node.GetAddress().String()] = true
+		relay, err := g.newRelay(chain, data, session.SessionBlockHeight, height, node)
+		if err != nil {
+			return "", err
+		}
+		start := time.Now()
+		resp, err := g.postRelay(node, relay)
+		if err != nil {
+			g.recordRelay(chain, session, node, 0, true, false)
+			lastErr = err
+			continue
+		}
+		// the node signs its response to the relay that was sent
+		signed := pocketTypes.RelayResponse{Response: resp.Response, Proof: relay.Proof}
+		if err := pocketTypes.SignatureVerification(node.GetPublicKey().RawString(), signed.HashString(), resp.Signature); err != nil {
+			g.recordRelay(chain, session, node, 0, true, true)
+			lastErr = err
+			continue
+		}
+		g.recordRelay(chain, session, node, time.Since(start), false, false)
+		return resp.Response, nil
+	}
+	return "", lastErr
+}
+
+// score the relay of the servicer and report the score
+func (g *Gateway) recordRelay(chain string, session *chainSession, node nodeexported.ValidatorI, latency time.Duration, failed, invalidSignature bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	addr := node.GetAddress().String()
+	score := session.scores[addr]
+	if failed {
+		score.recordFailure(invalidSignature)
+	} else {
+		score.recordSuccess(latency)
+	}
+	labels := []string{"chain", chain, "node", addr}
+	g.metrics.Relays.With(labels...).Add(1)
+	g.metrics.Latency.With(labels...).Set(score.latency.Seconds())
+	g.metrics.ErrorRate.With(labels...).Set(score.errorRate())
+	if invalidSignature {
+		g.metrics.InvalidSignatures.With(labels...).Add(1)
+	}
+	demoted := 0.0
+	if score.isDemoted() {
+		demoted = 1
+	}
+	g.metrics.Demoted.With(labels...).Set(demoted)
+}
+
+// retrieve the session of the chain, dispatching a new one at every session block
+func (g *Gateway) session(chain string, height int64) (*chainSession, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if s, found := g.sessions[chain]; found && s.isCurrent(height) {
+		return s, nil
+	}
+	// an empty session block height dispatches the latest session
+	res, err := g.queryDispatch(pocketTypes.SessionHeader{ApplicationPubKey: g.appPubKey, Chain: chain})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Session.SessionNodes) == 0 {
+		return nil, pocketTypes.NewInsufficientNodesError(pocketTypes.ModuleName)
+	}
+	frequency, err := g.querySessionFrequency(height)
+	if err != nil {
+		return nil, err
+	}
+	s := &chainSession{Session: res.Session, frequency: frequency, scores: newScoreboard(res.Session.SessionNodes)}
+	g.sessions[chain] = s
+	return s, nil
+}
+
+// create the relay to the servicer, signed by the client key
+func (g *Gateway) newRelay(chain, data string, sessionBlockHeight, height int64, node nodeexported.ValidatorI) (pocketTypes.Relay, error) {
+	entropy, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
+	if err != nil {
+		return pocketTypes.Relay{}, err
+	}
+	relay := pocketTypes.Relay{
+		Payload: pocketTypes.Payload{Data: data},
+		Meta:    pocketTypes.RelayMeta{BlockHeight: height},
+		Proof: pocketTypes.RelayProof{
+			Entropy:            entropy.Int64(),
+			SessionBlockHeight: sessionBlockHeight,
+			ServicerPubKey:     node.GetPublicKey().RawString(),
+			Blockchain:         chain,
+			Token:              g.aat,
+		},
+	}
+	relay.Proof.RequestHash = relay.RequestHashString()
+	sig, err := g.clientKey.Sign(relay.Proof.Hash())
+	if err != nil {
+		return pocketTypes.Relay{}, err
+	}
+	relay.Proof.Signature = hex.EncodeToString(sig)
+	return relay, nil
+}
+
+// send the relay to the service api of the node
+func (g *Gateway) postRelay(node nodeexported.ValidatorI, relay pocketTypes.Relay) (*pocketTypes.RelayResponse, error) {
+	bz, err := json.Marshal(relay)
+	if err != nil {
+		return nil, err
+	}
+	url := strings.TrimRight(node.GetServiceURL(), "/") + relayPath
+	resp, err := g.client.Post(url, "application/json", bytes.NewBuffer(bz))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("relay to %s failed with status %d: %s", node.GetServiceURL(), resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	var res pocketTypes.RelayResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+	if err := res.Validate(); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
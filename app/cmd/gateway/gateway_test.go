@@ -0,0 +1,165 @@
+package gateway
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	nodeexported "github.com/pokt-network/pocket-core/x/nodes/exported"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/pokt-network/posmint/crypto"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var testChain = hex.EncodeToString(pocketTypes.Hash([]byte("chain")))
+
+// a servicer that validates the relay of the client and answers with the upstream response
+func newTestServicer(t *testing.T, key crypto.PrivateKey, upstream string, validSignature bool) (*httptest.Server, nodesTypes.Validator) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, relayPath, r.URL.Path)
+		var relay pocketTypes.Relay
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&relay))
+		assert.Equal(t, relay.RequestHashString(), relay.Proof.RequestHash)
+		assert.Equal(t, key.PublicKey().RawString(), relay.Proof.ServicerPubKey)
+		assert.Nil(t, relay.Proof.Token.Validate())
+		assert.Nil(t, pocketTypes.SignatureVerification(relay.Proof.Token.ClientPublicKey, relay.Proof.HashString(), relay.Proof.Signature))
+		resp := pocketTypes.RelayResponse{Response: upstream, Proof: relay.Proof}
+		if !validSignature {
+			resp.Response = "forged"
+		}
+		sig, err := key.Sign(resp.Hash())
+		assert.Nil(t, err)
+		resp.Response = upstream
+		resp.Signature = hex.EncodeToString(sig)
+		assert.Nil(t, json.NewEncoder(w).Encode(resp))
+	}))
+	return server, nodesTypes.Validator{Address: sdk.Address(key.PublicKey().Address()), PublicKey: key.PublicKey(), ServiceURL: server.URL}
+}
+
+// a gateway of a random application with the chain queries replaced by the session nodes
+func newTestGateway(t *testing.T, height *int64, nodes []nodeexported.ValidatorI) (*Gateway, *int) {
+	appKey := crypto.GenerateEd25519PrivKey()
+	clientKey := crypto.GenerateEd25519PrivKey()
+	aat := pocketTypes.AAT{
+		Version:              pocketTypes.SUPPORTEDTOKENVERSION,
+		ApplicationPublicKey: appKey.PublicKey().RawString(),
+		ClientPublicKey:      clientKey.PublicKey().RawString(),
+	}
+	sig, err := appKey.Sign(aat.Hash())
+	assert.Nil(t, err)
+	aat.ApplicationSignature = hex.EncodeToString(sig)
+	dispatches := 0
+	return &Gateway{
+		appPubKey: aat.ApplicationPublicKey,
+		clientKey: clientKey,
+		aat:       aat,
+		sessions:  make(map[string]*chainSession),
+		client:    http.DefaultClient,
+		metrics:   NopMetrics(),
+		queryHeight: func() (int64, error) {
+			return *height, nil
+		},
+		queryDispatch: func(header pocketTypes.SessionHeader) (*pocketTypes.DispatchResponse, error) {
+			dispatches++
+			assert.Equal(t, aat.ApplicationPublicKey, header.ApplicationPubKey)
+			header.SessionBlockHeight = ((*height-1)/4)*4 + 1
+			return &pocketTypes.DispatchResponse{Session: pocketTypes.Session{SessionHeader: header, SessionNodes: nodes}, BlockHeight: *height}, nil
+		},
+		querySessionFrequency: func(height int64) (int64, error) {
+			return 4, nil
+		},
+	}, &dispatches
+}
+
+func TestGateway_SendRelay(t *testing.T) {
+	key1, key2 := crypto.GenerateEd25519PrivKey(), crypto.GenerateEd25519PrivKey()
+	server1, node1 := newTestServicer(t, key1, "response", true)
+	defer server1.Close()
+	server2, node2 := newTestServicer(t, key2, "response", true)
+	defer server2.Close()
+	height := int64(2)
+	g, dispatches := newTestGateway(t, &height, []nodeexported.ValidatorI{node1, node2})
+	// the session is refreshed at the session block
+	for i := 0; i < 4; i++ {
+		res, err := g.SendRelay(testChain, `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`)
+		assert.Nil(t, err)
+		assert.Equal(t, "response", res)
+		height++
+	}
+	assert.Equal(t, 2, *dispatches)
+	assert.Equal(t, int64(5), g.sessions[testChain].SessionBlockHeight)
+	// an invalid chain is rejected before dispatching
+	_, err := g.SendRelay("invalid", "data")
+	assert.NotNil(t, err)
+}
+
+func TestGateway_SendRelayInvalidSignature(t *testing.T) {
+	forger, forgerNode := newTestServicer(t, crypto.GenerateEd25519PrivKey(), "response", false)
+	defer forger.Close()
+	server, node := newTestServicer(t, crypto.GenerateEd25519PrivKey(), "response", true)
+	defer server.Close()
+	height := int64(2)
+	g, _ := newTestGateway(t, &height, []nodeexported.ValidatorI{forgerNode, node})
+	// the relay is retried on the other node and the forger is demoted
+	res, err := g.SendRelay(testChain, "data")
+	assert.Nil(t, err)
+	assert.Equal(t, "response", res)
+	score := g.sessions[testChain].scores[forgerNode.GetAddress().String()]
+	assert.Equal(t, int64(1), score.invalidSignatures)
+	assert.True(t, score.isDemoted())
+	// a session without a valid node fails
+	g, _ = newTestGateway(t, &height, []nodeexported.ValidatorI{forgerNode})
+	_, err = g.SendRelay(testChain, "data")
+	assert.NotNil(t, err)
+}
+
+func TestGateway_SendRelayRetry(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	failingKey := crypto.GenerateEd25519PrivKey()
+	failingNode := nodesTypes.Validator{Address: sdk.Address(failingKey.PublicKey().Address()), PublicKey: failingKey.PublicKey(), ServiceURL: failing.URL}
+	server, node := newTestServicer(t, crypto.GenerateEd25519PrivKey(), "response", true)
+	defer server.Close()
+	height := int64(2)
+	g, _ := newTestGateway(t, &height, []nodeexported.ValidatorI{failingNode, node})
+	// the failed relay is retried on the other node, which is preferred afterwards
+	for i := 0; i < 3; i++ {
+		res, err := g.SendRelay(testChain, "data")
+		assert.Nil(t, err)
+		assert.Equal(t, "response", res)
+	}
+	scores := g.sessions[testChain].scores
+	assert.Equal(t, int64(1), scores[failingNode.GetAddress().String()].errors)
+	assert.Equal(t, int64(3), scores[node.GetAddress().String()].relays)
+	assert.Equal(t, float64(0), scores[node.GetAddress().String()].errorRate())
+}
+
+func TestGateway_Relay(t *testing.T) {
+	server, node := newTestServicer(t, crypto.GenerateEd25519PrivKey(), `{"jsonrpc":"2.0","result":"0x1","id":1}`, true)
+	defer server.Close()
+	height := int64(2)
+	g, _ := newTestGateway(t, &height, []nodeexported.ValidatorI{node})
+	router := g.Router()
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/relay/"+testChain, strings.NewReader(`{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`))
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `{"jsonrpc":"2.0","result":"0x1","id":1}`, rec.Body.String())
+	// the errors of the network are answered as a bad gateway
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/relay/invalid", strings.NewReader("data"))
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadGateway, rec.Code)
+	// the servicer scores are exposed as metrics
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest("GET", "/metrics", nil)
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,75 @@
+package gateway
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this package.
+	MetricsSubsystem = "gateway"
+)
+
+// Metrics contains the servicer scores exposed by this package, labeled by chain and node address.
+type Metrics struct {
+	// Number of relays sent to the servicer.
+	Relays metrics.Counter
+	// Average latency of the successful relays of the servicer in seconds.
+	Latency metrics.Gauge
+	// Share of the relays of the servicer in the session that failed.
+	ErrorRate metrics.Gauge
+	// Number of responses of the servicer with an invalid signature.
+	InvalidSignatures metrics.Counter
+	// Whether the servicer is demoted for the rest of the session.
+	Demoted metrics.Gauge
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+func PrometheusMetrics(namespace string) *Metrics {
+	labels := []string{"chain", "node"}
+	return &Metrics{
+		Relays: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "servicer_relays",
+			Help:      "Number of relays sent to the servicer.",
+		}, labels),
+		Latency: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "servicer_latency_seconds",
+			Help:      "Average latency of the successful relays of the servicer in the session.",
+		}, labels),
+		ErrorRate: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "servicer_error_rate",
+			Help:      "Share of the relays of the servicer in the session that failed.",
+		}, labels),
+		InvalidSignatures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "servicer_invalid_signatures",
+			Help:      "Number of responses of the servicer with an invalid signature.",
+		}, labels),
+		Demoted: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "servicer_demoted",
+			Help:      "Whether the servicer is demoted for the rest of the session.",
+		}, labels),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		Relays:            discard.NewCounter(),
+		Latency:           discard.NewGauge(),
+		ErrorRate:         discard.NewGauge(),
+		InvalidSignatures: discard.NewCounter(),
+		Demoted:           discard.NewGauge(),
+	}
+}
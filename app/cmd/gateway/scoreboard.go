@@ -0,0 +1,106 @@
+package gateway
+
+import (
+	"math"
+	"time"
+
+	nodeexported "github.com/pokt-network/pocket-core/x/nodes/exported"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+const (
+	// the weight of the latest relay in the average latency of a servicer
+	latencyWeight = 0.2
+	// the consecutive failures after which a servicer is demoted for the rest of the session
+	maxConsecutiveFailures = 3
+)
+
+// the relay statistics of a servicer within a session
+type servicerScore struct {
+	relays              int64
+	errors              int64
+	invalidSignatures   int64
+	consecutiveFailures int64
+	latency             time.Duration // moving average of the successful relays
+}
+
+// the share of the relays that failed
+func (s *servicerScore) errorRate() float64 {
+	if s.relays == 0 {
+		return 0
+	}
+	return float64(s.errors) / float64(s.relays)
+}
+
+// a servicer that keeps failing or signs invalid responses is only used when no other servicer is left
+func (s *servicerScore) isDemoted() bool {
+	return s.invalidSignatures > 0 || s.consecutiveFailures >= maxConsecutiveFailures
+}
+
+// the expected time of a successful relay, the lower the better; untried servicers come first
+func (s *servicerScore) cost() float64 {
+	if s.relays == 0 {
+		return 0
+	}
+	if s.relays == s.errors {
+		return math.MaxFloat64
+	}
+	return float64(s.latency) / (1 - s.errorRate())
+}
+
+// record a relay answered with a valid response
+func (s *servicerScore) recordSuccess(latency time.Duration) {
+	if s.relays == s.errors {
+		s.latency = latency
+	} else {
+		s.latency = time.Duration(latencyWeight*float64(latency) + (1-latencyWeight)*float64(s.latency))
+	}
+	s.relays++
+	s.consecutiveFailures = 0
+}
+
+// record a failed relay
+func (s *servicerScore) recordFailure(invalidSignature bool) {
+	s.relays++
+	s.errors++
+	s.consecutiveFailures++
+	if invalidSignature {
+		s.invalidSignatures++
+	}
+}
+
+// the scores of the servicers of a session, by node address
+type scoreboard map[string]*servicerScore
+
+// a blank scoreboard for the session nodes
+func newScoreboard(nodes pocketTypes.SessionNodes) scoreboard {
+	sb := make(scoreboard, len(nodes))
+	for _, node := range nodes {
+		sb[node.GetAddress().String()] = &servicerScore{}
+	}
+	return sb
+}
+
+// the best servicer of the session that was not tried yet, demoted servicers come last
+func (sb scoreboard) best(nodes pocketTypes.SessionNodes, tried map[string]bool) (best nodeexported.ValidatorI, found bool) {
+	var bestScore *servicerScore
+	for _, node := range nodes {
+		addr := node.GetAddress().String()
+		if tried[addr] {
+			continue
+		}
+		score := sb[addr]
+		if bestScore == nil || isBetter(score, bestScore) {
+			best, bestScore = node, score
+		}
+	}
+	return best, bestScore != nil
+}
+
+// is the servicer score better than the other? ties keep the session order
+func isBetter(score, other *servicerScore) bool {
+	if score.isDemoted() != other.isDemoted() {
+		return other.isDemoted()
+	}
+	return score.cost() < other.cost()
+}
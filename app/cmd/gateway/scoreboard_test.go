@@ -0,0 +1,77 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	nodeexported "github.com/pokt-network/pocket-core/x/nodes/exported"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/pokt-network/posmint/crypto"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestSessionNodes(n int) pocketTypes.SessionNodes {
+	nodes := make(pocketTypes.SessionNodes, n)
+	for i := range nodes {
+		pk := crypto.GenerateEd25519PrivKey().PublicKey()
+		nodes[i] = nodesTypes.Validator{Address: sdk.Address(pk.Address()), PublicKey: pk}
+	}
+	return nodes
+}
+
+func TestScoreboard_Best(t *testing.T) {
+	nodes := newTestSessionNodes(3)
+	sb := newScoreboard(nodes)
+	score := func(node nodeexported.ValidatorI) *servicerScore {
+		return sb[node.GetAddress().String()]
+	}
+	// untried nodes come first, in session order
+	best, found := sb.best(nodes, nil)
+	assert.True(t, found)
+	assert.Equal(t, nodes[0], best)
+	score(nodes[0]).recordSuccess(100 * time.Millisecond)
+	best, _ = sb.best(nodes, nil)
+	assert.Equal(t, nodes[1], best)
+	score(nodes[1]).recordSuccess(50 * time.Millisecond)
+	score(nodes[2]).recordSuccess(200 * time.Millisecond)
+	// then the lowest latency
+	best, _ = sb.best(nodes, nil)
+	assert.Equal(t, nodes[1], best)
+	// the errors inflate the expected latency
+	score(nodes[1]).recordFailure(false)
+	score(nodes[1]).recordFailure(false)
+	best, _ = sb.best(nodes, nil)
+	assert.Equal(t, nodes[0], best)
+	// the tried nodes are skipped
+	best, _ = sb.best(nodes, map[string]bool{nodes[0].GetAddress().String(): true})
+	assert.Equal(t, nodes[1], best)
+	// failing nodes are demoted behind the slower ones
+	score(nodes[0]).recordFailure(false)
+	score(nodes[0]).recordFailure(false)
+	score(nodes[0]).recordFailure(false)
+	assert.True(t, score(nodes[0]).isDemoted())
+	score(nodes[1]).recordFailure(true)
+	assert.True(t, score(nodes[1]).isDemoted())
+	best, _ = sb.best(nodes, nil)
+	assert.Equal(t, nodes[2], best)
+	// a demoted node is used when no other node is left
+	best, found = sb.best(nodes, map[string]bool{nodes[2].GetAddress().String(): true})
+	assert.True(t, found)
+	assert.NotEqual(t, nodes[2], best)
+	_, found = sb.best(nodes, map[string]bool{nodes[0].GetAddress().String(): true, nodes[1].GetAddress().String(): true, nodes[2].GetAddress().String(): true})
+	assert.False(t, found)
+}
+
+func TestServicerScore_RecordSuccess(t *testing.T) {
+	score := &servicerScore{}
+	score.recordFailure(false)
+	score.recordSuccess(100 * time.Millisecond)
+	assert.Equal(t, 100*time.Millisecond, score.latency)
+	assert.Equal(t, int64(0), score.consecutiveFailures)
+	assert.Equal(t, 0.5, score.errorRate())
+	// the latency is a moving average
+	score.recordSuccess(200 * time.Millisecond)
+	assert.Equal(t, 120*time.Millisecond, score.latency)
+}
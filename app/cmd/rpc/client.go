@@ -75,6 +75,28 @@ func Challenge(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+func Timeout(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var timeout = types.ChallengeProofTimeout{}
+	if !cors(&w, r) {
+		return
+	}
+	if err := PopModel(w, r, ps, &timeout); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.QueryTimeout(timeout)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, er := json.Marshal(res)
+	if er != nil {
+		WriteErrorResponse(w, 400, er.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 type sendRawTxParams struct {
 	Addr        string `json:"address"`
 	RawHexBytes string `json:"raw_hex_bytes"`
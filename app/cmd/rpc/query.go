@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"math/big"
 	"net/http"
-	"strings"
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/pokt-network/pocket-core/app"
@@ -29,9 +28,21 @@ type heightAddrParams struct {
 	Address string `json:"address"`
 }
 
-type heightAndStakingStatusParams struct {
+type heightAddrChainParams struct {
+	Height     int64  `json:"height"`
+	Address    string `json:"address"`
+	Blockchain string `json:"blockchain"`
+}
+
+type heightAndFilterParams struct {
 	Height        int64  `json:"height"`
 	StakingStatus string `json:"staking_status"`
+	JailedStatus  string `json:"jailed_status"`
+	Blockchain    string `json:"blockchain"`
+	MinimumStake  int64  `json:"minimum_stake"`
+	SortBy        string `json:"sort_by"`
+	Page          int    `json:"page"`
+	PerPage       int    `json:"per_page"`
 }
 
 func Block(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
@@ -128,29 +139,29 @@ func Account(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 }
 
 func Nodes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-	var params = heightAndStakingStatusParams{Height: 0, StakingStatus: ""}
+	var params = heightAndFilterParams{Height: 0, Page: 1}
 	if err := PopModel(w, r, ps, &params); err != nil {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
-	var res nodeTypes.Validators
-	var err error
-	switch strings.ToLower(params.StakingStatus) {
-	case "":
-		// no status passed
-		res, err = app.QueryAllNodes(params.Height)
-	case "staked":
-		// staked nodes
-		res, err = app.QueryStakedNodes(params.Height)
-	case "unstaked":
-		// unstaked nodes
-		res, err = app.QueryUnstakedNodes(params.Height)
-	case "unstaking":
-		// unstaking nodes
-		res, err = app.QueryUnstakingNodes(params.Height)
-	default:
-		panic("invalid staking status, can be staked, unstaked, unstaking, or empty")
+	// the first page by default
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	opts := nodeTypes.QueryValidatorsParams{
+		Page:          params.Page,
+		Limit:         params.PerPage,
+		StakingStatus: params.StakingStatus,
+		JailedStatus:  params.JailedStatus,
+		Blockchain:    params.Blockchain,
+		MinimumStake:  params.MinimumStake,
+		SortBy:        params.SortBy,
+	}
+	if err := opts.Validate(); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
 	}
+	res, err := app.QueryNodes(params.Height, opts)
 	if err != nil {
 		WriteErrorResponse(w, 400, err.Error())
 		return
@@ -185,6 +196,63 @@ func Node(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+func NodeSlashes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = heightAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.QueryNodeSlashes(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func NodeUnstaking(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = heightAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.QueryNodeUnstaking(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func NodeEarnings(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = heightAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.QueryNodeEarnings(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func NodeParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = heightParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -252,29 +320,29 @@ func NodeReceipt(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 }
 
 func Apps(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-	var params = heightAndStakingStatusParams{Height: 0}
+	var params = heightAndFilterParams{Height: 0, Page: 1}
 	if err := PopModel(w, r, ps, &params); err != nil {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
-	var res appTypes.Applications
-	var err error
-	switch strings.ToLower(params.StakingStatus) {
-	case "":
-		// no status passed
-		res, err = app.QueryAllApps(params.Height)
-	case "staked":
-		// staked nodes
-		res, err = app.QueryStakedApps(params.Height)
-	case "unstaked":
-		// unstaked nodes
-		res, err = app.QueryUnstakedApps(params.Height)
-	case "unstaking":
-		// unstaking nodes
-		res, err = app.QueryUnstakingApps(params.Height)
-	default:
-		panic("invalid staking status, can be staked, unstaked, unstaking, or empty")
+	// the first page by default
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	opts := appTypes.QueryAppsParams{
+		Page:          params.Page,
+		Limit:         params.PerPage,
+		StakingStatus: params.StakingStatus,
+		JailedStatus:  params.JailedStatus,
+		Blockchain:    params.Blockchain,
+		MinimumStake:  params.MinimumStake,
+		SortBy:        params.SortBy,
+	}
+	if err := opts.Validate(); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
 	}
+	res, err := app.QueryApps(params.Height, opts)
 	if err != nil {
 		WriteErrorResponse(w, 400, err.Error())
 		return
@@ -309,6 +377,44 @@ func App(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+func AppUsage(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = heightAddrChainParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.QueryAppUsage(params.Address, params.Blockchain, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func AppUnstaking(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = heightAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.QueryAppUnstaking(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func AppParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = heightParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
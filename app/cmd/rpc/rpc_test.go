@@ -168,7 +168,7 @@ func TestRPC_QueryNodes(t *testing.T) {
 		kb := getInMemoryKeybase()
 		cb, err := kb.GetCoinbase()
 		assert.Nil(t, err)
-		var params = heightAndStakingStatusParams{
+		var params = heightAndFilterParams{
 			Height:        0,
 			StakingStatus: "staked",
 		}
@@ -181,6 +181,34 @@ func TestRPC_QueryNodes(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_QueryNodesFiltered(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	select {
+	case <-evtChan:
+		kb := getInMemoryKeybase()
+		cb, err := kb.GetCoinbase()
+		assert.Nil(t, err)
+		// the only node is not jailed
+		q := newQueryRequest("nodes", newBody(heightAndFilterParams{JailedStatus: "jailed", Page: 1}))
+		rec := httptest.NewRecorder()
+		Nodes(rec, q, httprouter.Params{})
+		assert.False(t, strings.Contains(rec.Body.String(), cb.GetAddress().String()))
+		// the only node is on the first page
+		q = newQueryRequest("nodes", newBody(heightAndFilterParams{SortBy: "tokens", Page: 2, PerPage: 1}))
+		rec = httptest.NewRecorder()
+		Nodes(rec, q, httprouter.Params{})
+		assert.Equal(t, "[]", rec.Body.String())
+		// invalid filters are rejected
+		q = newQueryRequest("nodes", newBody(heightAndFilterParams{StakingStatus: "foo", Page: 1}))
+		rec = httptest.NewRecorder()
+		Nodes(rec, q, httprouter.Params{})
+		assert.Equal(t, 400, rec.Code)
+	}
+	cleanup()
+	stopCli()
+}
+
 func TestRPC_QueryNode(t *testing.T) {
 	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
 	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
@@ -205,6 +233,96 @@ func TestRPC_QueryNode(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_QueryNodeSlashes(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	select {
+	case <-evtChan:
+		kb := getInMemoryKeybase()
+		cb, err := kb.GetCoinbase()
+		assert.Nil(t, err)
+		var params = heightAddrParams{
+			Height:  0,
+			Address: cb.GetAddress().String(),
+		}
+		q := newQueryRequest("nodeslashes", newBody(params))
+		rec := httptest.NewRecorder()
+		NodeSlashes(rec, q, httprouter.Params{})
+		resp := getResponse(rec)
+		assert.Equal(t, "[]", resp)
+	}
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_QueryNodeUnstaking(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	select {
+	case <-evtChan:
+		kb := getInMemoryKeybase()
+		cb, err := kb.GetCoinbase()
+		assert.Nil(t, err)
+		var params = heightAddrParams{
+			Height:  0,
+			Address: cb.GetAddress().String(),
+		}
+		q := newQueryRequest("nodeunstaking", newBody(params))
+		rec := httptest.NewRecorder()
+		NodeUnstaking(rec, q, httprouter.Params{})
+		resp := getResponse(rec)
+		assert.Equal(t, "[]", resp)
+	}
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_QueryAppUnstaking(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	select {
+	case <-evtChan:
+		kb := getInMemoryKeybase()
+		cb, err := kb.GetCoinbase()
+		assert.Nil(t, err)
+		var params = heightAddrParams{
+			Height:  0,
+			Address: cb.GetAddress().String(),
+		}
+		q := newQueryRequest("appunstaking", newBody(params))
+		rec := httptest.NewRecorder()
+		AppUnstaking(rec, q, httprouter.Params{})
+		resp := getResponse(rec)
+		assert.Equal(t, "[]", resp)
+	}
+	cleanup()
+	stopCli()
+}
+
+func TestRPC_QueryNodeEarnings(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	select {
+	case <-evtChan:
+		kb := getInMemoryKeybase()
+		cb, err := kb.GetCoinbase()
+		assert.Nil(t, err)
+		var params = heightAddrParams{
+			Height:  0,
+			Address: cb.GetAddress().String(),
+		}
+		q := newQueryRequest("nodeearnings", newBody(params))
+		rec := httptest.NewRecorder()
+		NodeEarnings(rec, q, httprouter.Params{})
+		resp := getJSONResponse(rec)
+		assert.NotNil(t, resp)
+		assert.NotEmpty(t, resp)
+		assert.True(t, strings.Contains(rec.Body.String(), "pending_relay_reward"))
+	}
+	cleanup()
+	stopCli()
+}
+
 func TestRPC_QueryApp(t *testing.T) {
 	gBZ, _, app := fiveValidatorsOneAppGenesis()
 	_, _, cleanup := NewInMemoryTendermintNode(t, gBZ)
@@ -227,13 +345,35 @@ func TestRPC_QueryApp(t *testing.T) {
 	stopCli()
 }
 
+func TestRPC_QueryAppUsage(t *testing.T) {
+	gBZ, _, app := fiveValidatorsOneAppGenesis()
+	_, _, cleanup := NewInMemoryTendermintNode(t, gBZ)
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	select {
+	case <-evtChan:
+		var params = heightAddrChainParams{
+			Height:  0,
+			Address: app.GetAddress().String(),
+		}
+		q := newQueryRequest("appusage", newBody(params))
+		rec := httptest.NewRecorder()
+		AppUsage(rec, q, httprouter.Params{})
+		resp := getJSONResponse(rec)
+		assert.NotNil(t, resp)
+		assert.NotEmpty(t, resp)
+		assert.True(t, strings.Contains(rec.Body.String(), "sessions"))
+	}
+	cleanup()
+	stopCli()
+}
+
 func TestRPC_QueryApps(t *testing.T) {
 	gBZ, _, app := fiveValidatorsOneAppGenesis()
 	_, _, cleanup := NewInMemoryTendermintNode(t, gBZ)
 	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
 	select {
 	case <-evtChan:
-		var params = heightAndStakingStatusParams{
+		var params = heightAndFilterParams{
 			Height:        0,
 			StakingStatus: "staked",
 		}
@@ -51,6 +51,7 @@ func GetRoutes() Routes {
 		Route{Name: "Dispatch", Method: "POST", Path: "/v1/client/dispatch", HandlerFunc: Dispatch},
 		Route{Name: "Service", Method: "POST", Path: "/v1/client/relay", HandlerFunc: Relay},
 		Route{Name: "Challenge", Method: "POST", Path: "/v1/client/challenge", HandlerFunc: Challenge},
+		Route{Name: "Timeout", Method: "POST", Path: "/v1/client/timeout", HandlerFunc: Timeout},
 		Route{Name: "SendRawTx", Method: "POST", Path: "/v1/client/rawtx", HandlerFunc: SendRawTx},
 		Route{Name: "QueryBlock", Method: "POST", Path: "/v1/query/block", HandlerFunc: Block},
 		Route{Name: "QueryTX", Method: "POST", Path: "/v1/query/tx", HandlerFunc: Tx},
@@ -59,11 +60,16 @@ func GetRoutes() Routes {
 		Route{Name: "QueryAccount", Method: "POST", Path: "/v1/query/account", HandlerFunc: Account},
 		Route{Name: "QueryNodes", Method: "POST", Path: "/v1/query/nodes", HandlerFunc: Nodes},
 		Route{Name: "QueryNode", Method: "POST", Path: "/v1/query/node", HandlerFunc: Node},
+		Route{Name: "QueryNodeSlashes", Method: "POST", Path: "/v1/query/nodeslashes", HandlerFunc: NodeSlashes},
+		Route{Name: "QueryNodeUnstaking", Method: "POST", Path: "/v1/query/nodeunstaking", HandlerFunc: NodeUnstaking},
+		Route{Name: "QueryNodeEarnings", Method: "POST", Path: "/v1/query/nodeearnings", HandlerFunc: NodeEarnings},
 		Route{Name: "QueryNodeParams", Method: "POST", Path: "/v1/query/nodeparams", HandlerFunc: NodeParams},
 		Route{Name: "QueryNodeReceipts", Method: "POST", Path: "/v1/query/nodereceipts", HandlerFunc: NodeReceipts},
 		Route{Name: "QueryNodeReceipt", Method: "POST", Path: "/v1/query/nodereceipt", HandlerFunc: NodeReceipt},
 		Route{Name: "QueryApps", Method: "POST", Path: "/v1/query/apps", HandlerFunc: Apps},
 		Route{Name: "QueryApp", Method: "POST", Path: "/v1/query/app", HandlerFunc: App},
+		Route{Name: "QueryAppUsage", Method: "POST", Path: "/v1/query/appusage", HandlerFunc: AppUsage},
+		Route{Name: "QueryAppUnstaking", Method: "POST", Path: "/v1/query/appunstaking", HandlerFunc: AppUnstaking},
 		Route{Name: "QueryAppParams", Method: "POST", Path: "/v1/query/appparams", HandlerFunc: AppParams},
 		Route{Name: "QueryPocketParams", Method: "POST", Path: "/v1/query/pocketparams", HandlerFunc: PocketParams},
 		Route{Name: "QuerySupportedChains", Method: "POST", Path: "/v1/query/supportedchains", HandlerFunc: SupportedChains},
@@ -464,11 +464,14 @@ func oneValTwoNodeGenesisState() []byte {
 	memCodec().MustUnmarshalJSON(rawPOS, &posGenesisState)
 	posGenesisState.Validators = append(posGenesisState.Validators,
 		nodesTypes.Validator{Address: sdk.Address(pubKey.Address()),
-			PublicKey:    pubKey,
-			Status:       sdk.Staked,
-			Chains:       []string{dummyChainsHash},
-			ServiceURL:   dummyServiceURL,
-			StakedTokens: sdk.NewInt(1000000000000000)})
+			PublicKey:       pubKey,
+			Status:          sdk.Staked,
+			Chains:          []string{dummyChainsHash},
+			ServiceURL:      dummyServiceURL,
+			StakedTokens:    sdk.NewInt(1000000000000000),
+			DelegatedTokens: sdk.ZeroInt(),
+			DelegatorShares: sdk.ZeroDec(),
+			Commission:      sdk.ZeroDec()})
 	res := memCodec().MustMarshalJSON(posGenesisState)
 	defaultGenesis[nodesTypes.ModuleName] = res
 	// set coinbase as account holding coins
@@ -529,16 +532,24 @@ func createTestACL(kp keys.KeyPair) govTypes.ACL {
 		acl.SetOwner("pos/StakeDenom", kp.GetAddress())
 		acl.SetOwner("pocketcore/SupportedBlockchains", kp.GetAddress())
 		acl.SetOwner("pos/DowntimeJailDuration", kp.GetAddress())
+		acl.SetOwner("pos/DowntimeJailEscalation", kp.GetAddress())
+		acl.SetOwner("pos/DowntimeJailDecayPeriod", kp.GetAddress())
+		acl.SetOwner("pos/EarningsRetention", kp.GetAddress())
 		acl.SetOwner("pos/SlashFractionDoubleSign", kp.GetAddress())
 		acl.SetOwner("pos/SlashFractionDowntime", kp.GetAddress())
 		acl.SetOwner("application/ApplicationStakeMinimum", kp.GetAddress())
 		acl.SetOwner("pocketcore/ClaimExpiration", kp.GetAddress())
 		acl.SetOwner("pocketcore/SessionNodeCount", kp.GetAddress())
+		acl.SetOwner("pocketcore/StakeWeightedSessions", kp.GetAddress())
+		acl.SetOwner("pocketcore/SessionNodeCountTiers", kp.GetAddress())
 		acl.SetOwner("pos/MaxValidators", kp.GetAddress())
 		acl.SetOwner("pos/ProposerPercentage", kp.GetAddress())
 		acl.SetOwner("application/StabilityAdjustment", kp.GetAddress())
 		acl.SetOwner("application/AppUnstakingTime", kp.GetAddress())
 		acl.SetOwner("application/ParticipationRateOn", kp.GetAddress())
+		acl.SetOwner("application/UsageRetention", kp.GetAddress())
+		acl.SetOwner("application/SlashFractionMisbehaviour", kp.GetAddress())
+		acl.SetOwner("application/MaxExcludedNodes", kp.GetAddress())
 		acl.SetOwner("pos/MaxEvidenceAge", kp.GetAddress())
 		acl.SetOwner("pos/MinSignedPerWindow", kp.GetAddress())
 		acl.SetOwner("pos/StakeMinimum", kp.GetAddress())
@@ -581,18 +592,24 @@ func twoValTwoNodeGenesisState() []byte {
 	memCodec().MustUnmarshalJSON(rawPOS, &posGenesisState)
 	posGenesisState.Validators = append(posGenesisState.Validators,
 		nodesTypes.Validator{Address: sdk.Address(pubKey.Address()),
-			PublicKey:    pubKey,
-			Status:       sdk.Staked,
-			Chains:       []string{dummyChainsHash},
-			ServiceURL:   dummyServiceURL,
-			StakedTokens: sdk.NewInt(1000000000000000)})
+			PublicKey:       pubKey,
+			Status:          sdk.Staked,
+			Chains:          []string{dummyChainsHash},
+			ServiceURL:      dummyServiceURL,
+			StakedTokens:    sdk.NewInt(1000000000000000),
+			DelegatedTokens: sdk.ZeroInt(),
+			DelegatorShares: sdk.ZeroDec(),
+			Commission:      sdk.ZeroDec()})
 	posGenesisState.Validators = append(posGenesisState.Validators,
 		nodesTypes.Validator{Address: sdk.Address(pubKey2.Address()),
-			PublicKey:    pubKey2,
-			Status:       sdk.Staked,
-			Chains:       []string{dummyChainsHash},
-			ServiceURL:   dummyServiceURL,
-			StakedTokens: sdk.NewInt(1000000000)})
+			PublicKey:       pubKey2,
+			Status:          sdk.Staked,
+			Chains:          []string{dummyChainsHash},
+			ServiceURL:      dummyServiceURL,
+			StakedTokens:    sdk.NewInt(1000000000),
+			DelegatedTokens: sdk.ZeroInt(),
+			DelegatorShares: sdk.ZeroDec(),
+			Commission:      sdk.ZeroDec()})
 	posGenesisState.Params.UnstakingTime = time.Nanosecond
 	posGenesisState.Params.SessionBlockFrequency = 5
 	res := memCodec().MustMarshalJSON(posGenesisState)
@@ -676,43 +693,58 @@ func fiveValidatorsOneAppGenesis() (genBz []byte, keys []crypto.PrivateKey, vali
 	// validator 1
 	posGenesisState.Validators = append(posGenesisState.Validators,
 		nodesTypes.Validator{Address: sdk.Address(pubKey.Address()),
-			PublicKey:    pubKey,
-			Status:       sdk.Staked,
-			Chains:       []string{dummyChainsHash},
-			ServiceURL:   dummyServiceURL,
-			StakedTokens: sdk.NewInt(1000000000000000000)})
+			PublicKey:       pubKey,
+			Status:          sdk.Staked,
+			Chains:          []string{dummyChainsHash},
+			ServiceURL:      dummyServiceURL,
+			StakedTokens:    sdk.NewInt(1000000000000000000),
+			DelegatedTokens: sdk.ZeroInt(),
+			DelegatorShares: sdk.ZeroDec(),
+			Commission:      sdk.ZeroDec()})
 	// validator 2
 	posGenesisState.Validators = append(posGenesisState.Validators,
 		nodesTypes.Validator{Address: sdk.Address(pubKey2.Address()),
-			PublicKey:    pubKey2,
-			Status:       sdk.Staked,
-			Chains:       []string{dummyChainsHash},
-			ServiceURL:   dummyServiceURL,
-			StakedTokens: sdk.NewInt(10000000)})
+			PublicKey:       pubKey2,
+			Status:          sdk.Staked,
+			Chains:          []string{dummyChainsHash},
+			ServiceURL:      dummyServiceURL,
+			StakedTokens:    sdk.NewInt(10000000),
+			DelegatedTokens: sdk.ZeroInt(),
+			DelegatorShares: sdk.ZeroDec(),
+			Commission:      sdk.ZeroDec()})
 	// validator 3
 	posGenesisState.Validators = append(posGenesisState.Validators,
 		nodesTypes.Validator{Address: sdk.Address(pubKey3.Address()),
-			PublicKey:    pubKey3,
-			Status:       sdk.Staked,
-			Chains:       []string{dummyChainsHash},
-			ServiceURL:   dummyServiceURL,
-			StakedTokens: sdk.NewInt(10000000)})
+			PublicKey:       pubKey3,
+			Status:          sdk.Staked,
+			Chains:          []string{dummyChainsHash},
+			ServiceURL:      dummyServiceURL,
+			StakedTokens:    sdk.NewInt(10000000),
+			DelegatedTokens: sdk.ZeroInt(),
+			DelegatorShares: sdk.ZeroDec(),
+			Commission:      sdk.ZeroDec()})
 	// validator 4
 	posGenesisState.Validators = append(posGenesisState.Validators,
 		nodesTypes.Validator{Address: sdk.Address(pubKey4.Address()),
-			PublicKey:    pubKey4,
-			Status:       sdk.Staked,
-			Chains:       []string{dummyChainsHash},
-			ServiceURL:   dummyServiceURL,
-			StakedTokens: sdk.NewInt(10000000)})
+			PublicKey:       pubKey4,
+			Status:          sdk.Staked,
+			Chains:          []string{dummyChainsHash},
+			ServiceURL:      dummyServiceURL,
+			StakedTokens:    sdk.NewInt(10000000),
+			DelegatedTokens: sdk.ZeroInt(),
+			DelegatorShares: sdk.ZeroDec(),
+			Commission:      sdk.ZeroDec()})
 	// validator 5
 	posGenesisState.Validators = append(posGenesisState.Validators,
 		nodesTypes.Validator{Address: sdk.Address(pubKey5.Address()),
-			PublicKey:    pubKey5,
-			Status:       sdk.Staked,
-			Chains:       []string{dummyChainsHash},
-			ServiceURL:   dummyServiceURL,
-			StakedTokens: sdk.NewInt(10000000)})
+			PublicKey:       pubKey5,
+			Status:          sdk.Staked,
+			Chains:          []string{dummyChainsHash},
+			ServiceURL:      dummyServiceURL,
+			StakedTokens:    sdk.NewInt(10000000),
+			DelegatedTokens: sdk.ZeroInt(),
+			DelegatorShares: sdk.ZeroDec(),
+			Commission:      sdk.ZeroDec()})
 	// marshal into json
 	res := memCodec().MustMarshalJSON(posGenesisState)
 	defaultGenesis[nodesTypes.ModuleName] = res
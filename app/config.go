@@ -74,6 +74,12 @@ var (
 	fs = string(fp.Separator)
 	// app instance currently running
 	pca *pocketCoreApp
+	// automatically unjail the self node
+	autoUnjail bool
+	// automatically restake the balance of the self node above the compound reserve
+	autoCompound bool
+	// the balance of the self node that is never restaked
+	compoundReserve int64
 )
 
 func InitApp(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort string, blockTime int) *node.Node {
@@ -325,10 +331,33 @@ func privValKey(password string) string {
 		fmt.Println("Initializing keyfiles: enter coinbase passphrase")
 		password = Credentials()
 	}
-	res, err := (keys).ExportPrivateKeyObject(coinbaseKeypair.GetAddress(), password)
+	err = writePrivValKey(keys, coinbaseKeypair.GetAddress(), password)
 	if err != nil {
 		panic(err)
 	}
+	return password
+}
+
+// SetConsensusKey makes the keybase account the consensus key of this node after a key rotation:
+// the account becomes the coinbase and the priv_val_key.json file used by tendermint and the relay servicer
+func SetConsensusKey(address, passphrase string) error {
+	addr, err := sdk.AddressFromHex(address)
+	if err != nil {
+		return err
+	}
+	keys := MustGetKeybase()
+	err = writePrivValKey(keys, addr, passphrase)
+	if err != nil {
+		return err
+	}
+	return keys.SetCoinbase(addr)
+}
+
+func writePrivValKey(keys kb.Keybase, address sdk.Address, password string) error {
+	res, err := (keys).ExportPrivateKeyObject(address, password)
+	if err != nil {
+		return err
+	}
 	privValKey := privval.FilePVKey{
 		Address: res.PubKey().Address(),
 		PubKey:  res.PubKey(),
@@ -336,19 +365,19 @@ func privValKey(password string) string {
 	}
 	pvkBz, err := cdc.MarshalJSONIndent(privValKey, "", "  ")
 	if err != nil {
-		panic(err)
+		return err
 	}
-	pvFile, err := os.OpenFile(datadir+fs+privValKeyName, os.O_RDWR|os.O_CREATE, 0666)
+	pvFile, err := os.OpenFile(datadir+fs+privValKeyName, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
 	if err != nil {
-		panic(err)
+		return err
 	}
+	defer pvFile.Close()
 	_, err = pvFile.Write(pvkBz)
 	if err != nil {
-		panic(err)
+		return err
 	}
 	types.InitPvKeyFile(privValKey)
-
-	return password
+	return nil
 }
 
 func nodeKey(password string) {
@@ -478,6 +507,13 @@ func SetTMNode(n string) {
 	tmNodeURI = n
 }
 
+// set the opt-in automatic unjail and reward compounding of the self node
+func SetNodeAgent(unjail, compound bool, reserve int64) {
+	autoUnjail = unjail
+	autoCompound = compound
+	compoundReserve = reserve
+}
+
 func setGenesisPath(filepath string) {
 	genesisFP = filepath
 }
@@ -621,16 +657,24 @@ func createDummyACL(kp crypto.PublicKey) govTypes.ACL {
 	acl.SetOwner("pos/StakeDenom", addr)
 	acl.SetOwner("pocketcore/SupportedBlockchains", addr)
 	acl.SetOwner("pos/DowntimeJailDuration", addr)
+	acl.SetOwner("pos/DowntimeJailEscalation", addr)
+	acl.SetOwner("pos/DowntimeJailDecayPeriod", addr)
+	acl.SetOwner("pos/EarningsRetention", addr)
 	acl.SetOwner("pos/SlashFractionDoubleSign", addr)
 	acl.SetOwner("pos/SlashFractionDowntime", addr)
 	acl.SetOwner("application/ApplicationStakeMinimum", addr)
 	acl.SetOwner("pocketcore/ClaimExpiration", addr)
 	acl.SetOwner("pocketcore/SessionNodeCount", addr)
+	acl.SetOwner("pocketcore/StakeWeightedSessions", addr)
+	acl.SetOwner("pocketcore/SessionNodeCountTiers", addr)
 	acl.SetOwner("pos/MaxValidators", addr)
 	acl.SetOwner("pos/ProposerPercentage", addr)
 	acl.SetOwner("application/StabilityAdjustment", addr)
 	acl.SetOwner("application/AppUnstakingTime", addr)
 	acl.SetOwner("application/ParticipationRateOn", addr)
+	acl.SetOwner("application/UsageRetention", addr)
+	acl.SetOwner("application/SlashFractionMisbehaviour", addr)
+	acl.SetOwner("application/MaxExcludedNodes", addr)
 	acl.SetOwner("pos/MaxEvidenceAge", addr)
 	acl.SetOwner("pos/MinSignedPerWindow", addr)
 	acl.SetOwner("pos/StakeMinimum", addr)
@@ -48,8 +48,8 @@ func QueryAccount(addr string, height int64) (account *auth.BaseAccount, err err
 	return nodes.QueryAccount(Codec(), getTMClient(), a, height)
 }
 
-func QueryAllNodes(height int64) (nodesTypes.Validators, error) {
-	return nodes.QueryValidators(Codec(), getTMClient(), height)
+func QueryNodes(height int64, opts nodesTypes.QueryValidatorsParams) (nodesTypes.Validators, error) {
+	return nodes.QueryValidators(Codec(), getTMClient(), height, opts)
 }
 
 func QueryNode(addr string, height int64) (validator nodesTypes.Validator, err error) {
@@ -60,16 +60,45 @@ func QueryNode(addr string, height int64) (validator nodesTypes.Validator, err e
 	return nodes.QueryValidator(Codec(), getTMClient(), a, height)
 }
 
-func QueryUnstakingNodes(height int64) (validators nodesTypes.Validators, err error) {
-	return nodes.QueryUnstakingValidators(Codec(), getTMClient(), height)
+func QueryDelegations(addr string, height int64) ([]nodesTypes.Delegation, error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return nil, err
+	}
+	return nodes.QueryDelegations(Codec(), getTMClient(), a, height)
+}
+
+func QueryUnstakingDelegations(addr string, height int64) ([]nodesTypes.UnstakingDelegation, error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return nil, err
+	}
+	return nodes.QueryUnstakingDelegations(Codec(), getTMClient(), a, height)
 }
 
-func QueryStakedNodes(height int64) (validators nodesTypes.Validators, err error) {
-	return nodes.QueryStakedValidators(Codec(), getTMClient(), height)
+func QueryNodeSlashes(addr string, height int64) ([]nodesTypes.SlashRecord, error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return nil, err
+	}
+	return nodes.QuerySlashRecords(Codec(), getTMClient(), a, height)
+}
+
+// an empty address returns the unstaking schedule of all the nodes
+func QueryNodeUnstaking(addr string, height int64) ([]nodesTypes.UnstakingEntry, error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return nil, err
+	}
+	return nodes.QueryUnstakingSchedule(Codec(), getTMClient(), a, height)
 }
 
-func QueryUnstakedNodes(height int64) (validators nodesTypes.Validators, err error) {
-	return nodes.QueryUnstakedValidators(Codec(), getTMClient(), height)
+func QueryNodeEarnings(addr string, height int64) (nodesTypes.ValidatorEarnings, error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return nodesTypes.ValidatorEarnings{}, err
+	}
+	return nodes.QueryEarnings(Codec(), getTMClient(), a, height)
 }
 
 func QueryNodeParams(height int64) (params nodesTypes.Params, err error) {
@@ -104,8 +133,8 @@ func QueryACL(height int64) (acl types.ACL, err error) {
 	return gov.QueryACL(Codec(), getTMClient(), height)
 }
 
-func QueryAllApps(height int64) (appsTypes.Applications, error) {
-	return apps.QueryApplications(Codec(), getTMClient(), height)
+func QueryApps(height int64, opts appsTypes.QueryAppsParams) (appsTypes.Applications, error) {
+	return apps.QueryApplications(Codec(), getTMClient(), height, opts)
 }
 
 func QueryApp(addr string, height int64) (validator appsTypes.Application, err error) {
@@ -116,16 +145,21 @@ func QueryApp(addr string, height int64) (validator appsTypes.Application, err e
 	return apps.QueryApplication(Codec(), getTMClient(), a, height)
 }
 
-func QueryUnstakingApps(height int64) (validators appsTypes.Applications, err error) {
-	return apps.QueryUnstakingApplications(Codec(), getTMClient(), height)
-}
-
-func QueryStakedApps(height int64) (validators appsTypes.Applications, err error) {
-	return apps.QueryStakedApplications(Codec(), getTMClient(), height)
+func QueryAppUsage(addr string, chain string, height int64) (appsTypes.AppUsage, error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return appsTypes.AppUsage{}, err
+	}
+	return apps.QueryAppUsage(Codec(), getTMClient(), a, chain, height)
 }
 
-func QueryUnstakedApps(height int64) (validators appsTypes.Applications, err error) {
-	return apps.QueryUnstakedApplications(Codec(), getTMClient(), height)
+// an empty address returns the unstaking schedule of all the apps
+func QueryAppUnstaking(addr string, height int64) ([]appsTypes.UnstakingEntry, error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return nil, err
+	}
+	return apps.QueryUnstakingSchedule(Codec(), getTMClient(), a, height)
 }
 
 func QueryTotalAppCoins(height int64) (staked sdk.Int, unstaked sdk.Int, err error) {
@@ -168,10 +202,23 @@ func QueryChallenge(c pocketTypes.ChallengeProofInvalidData) (*pocketTypes.Chall
 	return pocket.QueryChallenge(Codec(), getTMClient(), c)
 }
 
+func QueryTimeout(t pocketTypes.ChallengeProofTimeout) (*pocketTypes.ChallengeResponse, error) {
+	return pocket.QueryTimeout(Codec(), getTMClient(), t)
+}
+
 func QueryDispatch(header pocketTypes.SessionHeader) (*pocketTypes.DispatchResponse, error) {
 	return pocket.QueryDispatch(Codec(), getTMClient(), header)
 }
 
+func QuerySession(appPubKey, chain string, sessionBlockHeight int64) (*pocketTypes.DispatchResponse, error) {
+	header := pocketTypes.SessionHeader{
+		ApplicationPubKey:  appPubKey,
+		Chain:              chain,
+		SessionBlockHeight: sessionBlockHeight,
+	}
+	return pocket.QueryDispatch(Codec(), getTMClient(), header)
+}
+
 func QueryState() (appState json.RawMessage, err error) {
 	return pca.ExportAppState(false, nil)
 }
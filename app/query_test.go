@@ -6,6 +6,7 @@ import (
 	"fmt"
 	apps "github.com/pokt-network/pocket-core/x/apps"
 	"github.com/pokt-network/pocket-core/x/nodes"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	pocket "github.com/pokt-network/pocket-core/x/pocketcore"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/pokt-network/posmint/crypto"
@@ -92,7 +93,7 @@ func TestQueryValidators(t *testing.T) {
 	memCli, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
 	select {
 	case <-evtChan:
-		got, err := nodes.QueryValidators(memCodec(), memCli, 1)
+		got, err := nodes.QueryValidators(memCodec(), memCli, 1, nodesTypes.NewQueryValidatorsParams(1, 0))
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(got))
 	}
@@ -335,7 +336,7 @@ func TestQueryProof(t *testing.T) {
 	select {
 	case <-evtChan:
 		var err error
-		tx, err = apps.StakeTx(memCodec(), memCli, kb, chains, sdk.NewInt(1000000), kp, "test")
+		tx, err = apps.StakeTx(memCodec(), memCli, kb, chains, nil, sdk.NewInt(1000000), kp, "test")
 		assert.Nil(t, err)
 		assert.NotNil(t, tx)
 		time.Sleep(time.Second / 2)
@@ -362,7 +363,7 @@ func TestQueryStakedApp(t *testing.T) {
 	case <-evtChan:
 		var err error
 		memCli, stopCli, evtChan = subscribeTo(t, tmTypes.EventTx)
-		tx, err = apps.StakeTx(memCodec(), memCli, kb, chains, sdk.NewInt(1000000), kp, "test")
+		tx, err = apps.StakeTx(memCodec(), memCli, kb, chains, nil, sdk.NewInt(1000000), kp, "test")
 		assert.Nil(t, err)
 		assert.NotNil(t, tx)
 	}
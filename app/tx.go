@@ -2,6 +2,7 @@ package app
 
 import (
 	apps "github.com/pokt-network/pocket-core/x/apps"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	"github.com/pokt-network/pocket-core/x/nodes"
 	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	sdk "github.com/pokt-network/posmint/types"
@@ -33,11 +34,19 @@ func SendRawTx(fromAddr string, txBytes []byte) (sdk.TxResponse, error) {
 	return nodes.RawTx(Codec(), getTMClient(), fa, txBytes)
 }
 
-func StakeNode(chains []string, serviceUrl, fromAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+func StakeNode(chains []string, serviceUrl, fromAddr, outputAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
 	}
+	// the output address is optional
+	var oa sdk.Address
+	if outputAddr != "" {
+		oa, err = sdk.AddressFromHex(outputAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
 	kp, err := (MustGetKeybase()).Get(fa)
 	if err != nil {
 		return nil, err
@@ -55,15 +64,119 @@ func StakeNode(chains []string, serviceUrl, fromAddr, passphrase string, amount
 	if err != nil {
 		return nil, err
 	}
-	return nodes.StakeTx(Codec(), getTMClient(), MustGetKeybase(), chains, serviceUrl, amount, kp, passphrase)
+	return nodes.StakeTx(Codec(), getTMClient(), MustGetKeybase(), chains, serviceUrl, amount, kp, oa, passphrase)
+}
+
+func UnstakeNode(nodeAddr, fromAddr, passphrase string) (*sdk.TxResponse, error) {
+	na, err := sdk.AddressFromHex(nodeAddr)
+	if err != nil {
+		return nil, err
+	}
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	return nodes.UnstakeTx(Codec(), getTMClient(), MustGetKeybase(), na, fa, passphrase)
+}
+
+func PartialUnstakeNode(nodeAddr, fromAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+	na, err := sdk.AddressFromHex(nodeAddr)
+	if err != nil {
+		return nil, err
+	}
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	return nodes.PartialUnstakeTx(Codec(), getTMClient(), MustGetKeybase(), na, fa, amount, passphrase)
+}
+
+func RotateNodeConsensusKey(nodeAddr, newKeyAddr, fromAddr, passphrase string) (*sdk.TxResponse, error) {
+	na, err := sdk.AddressFromHex(nodeAddr)
+	if err != nil {
+		return nil, err
+	}
+	nka, err := sdk.AddressFromHex(newKeyAddr)
+	if err != nil {
+		return nil, err
+	}
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kp, err := MustGetKeybase().Get(nka)
+	if err != nil {
+		return nil, err
+	}
+	return nodes.RotateConsensusKeyTx(Codec(), getTMClient(), MustGetKeybase(), na, fa, kp.PublicKey, passphrase)
+}
+
+func ChangeNodeOutput(nodeAddr, outputAddr, fromAddr, passphrase string) (*sdk.TxResponse, error) {
+	na, err := sdk.AddressFromHex(nodeAddr)
+	if err != nil {
+		return nil, err
+	}
+	oa, err := sdk.AddressFromHex(outputAddr)
+	if err != nil {
+		return nil, err
+	}
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	return nodes.ChangeOutputTx(Codec(), getTMClient(), MustGetKeybase(), na, oa, fa, passphrase)
 }
 
-func UnstakeNode(fromAddr, passphrase string) (*sdk.TxResponse, error) {
+func DelegateToNode(fromAddr, nodeAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	return nodes.UnstakeTx(Codec(), getTMClient(), MustGetKeybase(), fa, passphrase)
+	na, err := sdk.AddressFromHex(nodeAddr)
+	if err != nil {
+		return nil, err
+	}
+	return nodes.DelegateTx(Codec(), getTMClient(), MustGetKeybase(), fa, na, amount, passphrase)
+}
+
+func UndelegateFromNode(fromAddr, nodeAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	na, err := sdk.AddressFromHex(nodeAddr)
+	if err != nil {
+		return nil, err
+	}
+	return nodes.UndelegateTx(Codec(), getTMClient(), MustGetKeybase(), fa, na, amount, passphrase)
+}
+
+func RedelegateNode(fromAddr, srcNodeAddr, dstNodeAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	sa, err := sdk.AddressFromHex(srcNodeAddr)
+	if err != nil {
+		return nil, err
+	}
+	da, err := sdk.AddressFromHex(dstNodeAddr)
+	if err != nil {
+		return nil, err
+	}
+	return nodes.RedelegateTx(Codec(), getTMClient(), MustGetKeybase(), fa, sa, da, amount, passphrase)
+}
+
+func SetNodeCommission(nodeAddr, fromAddr, passphrase string, commission sdk.Dec) (*sdk.TxResponse, error) {
+	na, err := sdk.AddressFromHex(nodeAddr)
+	if err != nil {
+		return nil, err
+	}
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	return nodes.SetCommissionTx(Codec(), getTMClient(), MustGetKeybase(), na, fa, commission, passphrase)
 }
 
 func UnjailNode(fromAddr, passphrase string) (*sdk.TxResponse, error) {
@@ -74,7 +187,7 @@ func UnjailNode(fromAddr, passphrase string) (*sdk.TxResponse, error) {
 	return nodes.UnjailTx(Codec(), getTMClient(), MustGetKeybase(), fa, passphrase)
 }
 
-func StakeApp(chains []string, fromAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+func StakeApp(chains []string, chainWeights []appsTypes.ChainWeight, fromAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
@@ -92,7 +205,7 @@ func StakeApp(chains []string, fromAddr, passphrase string, amount sdk.Int) (*sd
 	if amount.LTE(sdk.NewInt(0)) {
 		return nil, sdk.ErrInternal("must stake above zero")
 	}
-	return apps.StakeTx(Codec(), getTMClient(), MustGetKeybase(), chains, amount, kp, passphrase)
+	return apps.StakeTx(Codec(), getTMClient(), MustGetKeybase(), chains, chainWeights, amount, kp, passphrase)
 }
 
 func UnstakeApp(fromAddr, passphrase string) (*sdk.TxResponse, error) {
@@ -103,6 +216,29 @@ func UnstakeApp(fromAddr, passphrase string) (*sdk.TxResponse, error) {
 	return apps.UnstakeTx(Codec(), getTMClient(), MustGetKeybase(), fa, passphrase)
 }
 
+func PartialUnstakeApp(fromAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	return apps.PartialUnstakeTx(Codec(), getTMClient(), MustGetKeybase(), fa, amount, passphrase)
+}
+
+func ExcludeNodesApp(fromAddr string, excludedNodes []string, passphrase string) (*sdk.TxResponse, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]sdk.Address, len(excludedNodes))
+	for i, node := range excludedNodes {
+		addrs[i], err = sdk.AddressFromHex(node)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return apps.ExcludeNodesTx(Codec(), getTMClient(), MustGetKeybase(), fa, addrs, passphrase)
+}
+
 func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.Int, action string) (*sdk.TxResponse, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	apps "github.com/pokt-network/pocket-core/x/apps"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	"github.com/pokt-network/pocket-core/x/nodes"
 	types2 "github.com/pokt-network/pocket-core/x/nodes/types"
 	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
@@ -32,13 +33,13 @@ func TestUnstakeApp(t *testing.T) {
 	case <-evtChan:
 		var err error
 		memCli, stopCli, evtChan = subscribeTo(t, tmTypes.EventTx)
-		tx, err = apps.StakeTx(memCodec(), memCli, kb, chains, sdk.NewInt(1000000), kp, "test")
+		tx, err = apps.StakeTx(memCodec(), memCli, kb, chains, nil, sdk.NewInt(1000000), kp, "test")
 		assert.Nil(t, err)
 		assert.NotNil(t, tx)
 	}
 	select {
 	case <-evtChan:
-		got, err := apps.QueryApplications(memCodec(), memCli, 0)
+		got, err := apps.QueryApplications(memCodec(), memCli, 0, appsTypes.NewQueryApplicationsParams(1, 0))
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(got))
 		memCli, stopCli, evtChan = subscribeTo(t, tmTypes.EventTx)
@@ -69,7 +70,7 @@ func TestUnstakeNode(t *testing.T) {
 		var err error
 		memCli, stopCli, evtChan = subscribeTo(t, tmTypes.EventTx)
 		_, err = nodes.QueryAccountBalance(memCodec(), memCli, kp.GetAddress(), 0)
-		tx, err = nodes.UnstakeTx(memCodec(), memCli, kb, kp.GetAddress(), "test")
+		tx, err = nodes.UnstakeTx(memCodec(), memCli, kb, kp.GetAddress(), kp.GetAddress(), "test")
 		assert.Nil(t, err)
 		assert.NotNil(t, tx)
 	}
@@ -97,7 +98,7 @@ func TestUnstakeNode(t *testing.T) {
 							addr := got[0].Address
 							balance, err := nodes.QueryAccountBalance(memCodec(), memCli, addr, 0)
 							assert.NotZero(t, balance.Int64())
-							tx, err = nodes.StakeTx(memCodec(), memCli, kb, chains, "https://myPocketNode:8080", sdk.NewInt(10000000), kp, "test")
+							tx, err = nodes.StakeTx(memCodec(), memCli, kb, chains, "https://myPocketNode:8080", sdk.NewInt(10000000), kp, nil, "test")
 							assert.Nil(t, err)
 							assert.NotNil(t, tx)
 							assert.True(t, strings.Contains(tx.Logs.String(), `"success":true`))
@@ -125,7 +126,7 @@ func TestStakeNode(t *testing.T) {
 	case <-evtChan:
 		var err error
 		memCli, stopCli, evtChan = subscribeTo(t, tmTypes.EventTx)
-		tx, err = nodes.StakeTx(memCodec(), memCli, kb, chains, "https://myPocketNode:8080", sdk.NewInt(10000000), kp, "test")
+		tx, err = nodes.StakeTx(memCodec(), memCli, kb, chains, "https://myPocketNode:8080", sdk.NewInt(10000000), kp, nil, "test")
 		assert.Nil(t, err)
 		assert.NotNil(t, tx)
 		assert.True(t, strings.Contains(tx.Logs.String(), `"success":true`))
@@ -146,13 +147,13 @@ func TestStakeApp(t *testing.T) {
 	case <-evtChan:
 		var err error
 		memCli, stopCli, evtChan = subscribeTo(t, tmTypes.EventTx)
-		tx, err = apps.StakeTx(memCodec(), memCli, kb, chains, sdk.NewInt(1000000), kp, "test")
+		tx, err = apps.StakeTx(memCodec(), memCli, kb, chains, nil, sdk.NewInt(1000000), kp, "test")
 		assert.Nil(t, err)
 		assert.NotNil(t, tx)
 	}
 	select {
 	case <-evtChan:
-		got, err := apps.QueryApplications(memCodec(), memCli, 0)
+		got, err := apps.QueryApplications(memCodec(), memCli, 0, appsTypes.NewQueryApplicationsParams(1, 0))
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(got))
 	}
@@ -18,6 +18,9 @@ func GenerateChain(ticker, netid, version, client, inter string) (chain string,
 
 func GenerateAAT(appPubKey, clientPubKey, passphrase string) (aatjson []byte, err error) {
 	aat, err := pocket.GenerateAAT(MustGetKeybase(), appPubKey, clientPubKey, passphrase)
+	if err != nil {
+		return nil, err
+	}
 	return json.MarshalIndent(aat, "", "  ")
 }
 
@@ -28,6 +28,16 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 			stakedTokens = stakedTokens.Add(application.GetTokens())
 		}
 	}
+	// the tokens of the partial unstakes remain in the staked pool until released
+	for _, pu := range data.PartialUnstakes {
+		keeper.SetPartialUnstake(ctx, pu)
+		stakedTokens = stakedTokens.Add(pu.Amount)
+	}
+	// the tokens added by the pending edits are held in the staked pool until applied
+	for _, edit := range data.AppEdits {
+		keeper.SetAppEdit(ctx, edit)
+		stakedTokens = stakedTokens.Add(edit.AddedTokens)
+	}
 	stakedCoins := sdk.NewCoins(sdk.NewCoin(posKeeper.StakeDenom(ctx), stakedTokens))
 	// check if the staked pool accounts exists
 	stakedPool := keeper.GetStakedPool(ctx)
@@ -53,10 +63,14 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 func ExportGenesis(ctx sdk.Ctx, keeper keeper.Keeper) types.GenesisState {
 	params := keeper.GetParams(ctx)
 	applications := keeper.GetAllApplications(ctx)
+	partialUnstakes := keeper.GetAllPartialUnstakes(ctx)
+	appEdits := keeper.GetAllAppEdits(ctx)
 	return types.GenesisState{
-		Params:       params,
-		Applications: applications,
-		Exported:     true,
+		Params:          params,
+		Applications:    applications,
+		Exported:        true,
+		PartialUnstakes: partialUnstakes,
+		AppEdits:        appEdits,
 	}
 }
 
@@ -17,6 +17,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgBeginUnstake(ctx, msg, k)
 		case types.MsgAppUnjail:
 			return handleMsgUnjail(ctx, msg, k)
+		case types.MsgAppPartialUnstake:
+			return handleMsgPartialUnstake(ctx, msg, k)
+		case types.MsgAppExcludeNodes:
+			return handleMsgExcludeNodes(ctx, msg, k)
 		default:
 			errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -28,6 +32,11 @@ func handleStake(ctx sdk.Ctx, msg types.MsgAppStake, k keeper.Keeper) sdk.Result
 	ctx.Logger().Info("Begin Staking App Message received from " + sdk.Address(msg.PubKey.Address()).String())
 	// create application object using the message fields
 	application := types.NewApplication(sdk.Address(msg.PubKey.Address()), msg.PubKey, msg.Chains, sdk.ZeroInt())
+	application.ChainWeights = msg.ChainWeights
+	// a staked application edits its stake and chains in place
+	if currentApp, found := k.GetApplication(ctx, application.Address); found && currentApp.IsStaked() {
+		return handleEditStake(ctx, msg, currentApp, application, k)
+	}
 	ctx.Logger().Info("Validate App Can Stake " + sdk.Address(msg.PubKey.Address()).String())
 	// check if they can stake
 	if err := k.ValidateApplicationStaking(ctx, application, msg.Value); err != nil {
@@ -61,6 +70,33 @@ func handleStake(ctx sdk.Ctx, msg types.MsgAppStake, k keeper.Keeper) sdk.Result
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+func handleEditStake(ctx sdk.Ctx, msg types.MsgAppStake, currentApp, application types.Application, k keeper.Keeper) sdk.Result {
+	ctx.Logger().Info("Validate App Can Edit Stake " + application.Address.String())
+	if err := k.ValidateEditStake(ctx, currentApp, msg.Value); err != nil {
+		ctx.Logger().Error("Validate App Can Edit Stake Error " + application.Address.String())
+		return err.Result()
+	}
+	// the edit is applied at the next session
+	if err := k.EditStakeApplication(ctx, currentApp, application, msg.Value); err != nil {
+		return err.Result()
+	}
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeEditStake,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, application.Address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Value.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, application.Address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 func handleMsgBeginUnstake(ctx sdk.Ctx, msg types.MsgBeginAppUnstake, k keeper.Keeper) sdk.Result {
 	ctx.Logger().Info("Begin Unstaking App Message received from " + msg.Address.String())
 	// move coins from the msg.Address account to a (self-delegation) delegator account
@@ -77,12 +113,72 @@ func handleMsgBeginUnstake(ctx sdk.Ctx, msg types.MsgBeginAppUnstake, k keeper.K
 	}
 	ctx.Logger().Info("Starting to Unstake App " + msg.Address.String())
 	k.BeginUnstakingApplication(ctx, application)
+	application, _ = k.GetApplication(ctx, msg.Address)
 	// create the event
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeBeginUnstake,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, application.StakedTokens.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, application.UnstakingCompletionTime.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgPartialUnstake(ctx sdk.Ctx, msg types.MsgAppPartialUnstake, k keeper.Keeper) sdk.Result {
+	ctx.Logger().Info("Partial Unstake App Message received from " + msg.Address.String())
+	application, found := k.GetApplication(ctx, msg.Address)
+	if !found {
+		ctx.Logger().Error("App Not Found " + msg.Address.String())
+		return types.ErrNoApplicationFound(k.Codespace()).Result()
+	}
+	if err := k.ValidateApplicationPartialUnstake(ctx, application, msg.Amount); err != nil {
+		ctx.Logger().Error("App Partial Unstake Validation Not Successful " + msg.Address.String())
+		return err.Result()
+	}
+	k.PartialUnstakeApplication(ctx, application, msg.Amount)
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePartialUnstake,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgExcludeNodes(ctx sdk.Ctx, msg types.MsgAppExcludeNodes, k keeper.Keeper) sdk.Result {
+	ctx.Logger().Info("Exclude Nodes App Message received from " + msg.Address.String())
+	application, found := k.GetApplication(ctx, msg.Address)
+	if !found {
+		ctx.Logger().Error("App Not Found " + msg.Address.String())
+		return types.ErrNoApplicationFound(k.Codespace()).Result()
+	}
+	if err := k.ValidateExcludeNodes(ctx, application, msg.ExcludedNodes); err != nil {
+		ctx.Logger().Error("App Exclude Nodes Validation Not Successful " + msg.Address.String())
+		return err.Result()
+	}
+	k.ExcludeNodesApplication(ctx, application, msg.ExcludedNodes)
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeExcludeNodes,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -7,20 +7,40 @@ import (
 )
 
 func BeginBlocker(ctx sdk.Ctx, _ abci.RequestBeginBlock, k Keeper) {
+	// backfill the defaults of the params added by the upgrade before they are read
+	k.BackfillParams(ctx)
 	// burn applications triggered by the custom burning interface
 	k.burnApplications(ctx)
+	// delete the relay usage past the retention
+	k.pruneUsages(ctx)
 }
 
 // Called every block, update application set
 func EndBlocker(ctx sdk.Ctx, k Keeper) []abci.ValidatorUpdate {
 	matureApplications := k.getMatureApplications(ctx)
+	// the tokens returned to the mature applications, read before they are unstaked
+	amounts := make([]sdk.Int, len(matureApplications))
+	for i, addr := range matureApplications {
+		amounts[i] = sdk.ZeroInt()
+		if application, found := k.GetApplication(ctx, addr); found {
+			amounts[i] = application.StakedTokens
+		}
+	}
 	// Unstake all mature applications from the unstakeing queue.
 	k.unstakeAllMatureApplications(ctx)
-	for _, valAddr := range matureApplications {
+	// Release the tokens of all mature partial unstakes.
+	k.unstakeAllMaturePartialUnstakes(ctx)
+	// Apply the stake edits and recalculate the relays the block before a session block.
+	if ctx.BlockHeight()%k.POSKeeper.SessionBlockFrequency(ctx) == 0 {
+		k.applyAppEdits(ctx)
+		k.recalculateAllAppRelays(ctx)
+	}
+	for i, valAddr := range matureApplications {
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeCompleteUnstaking,
 				sdk.NewAttribute(types.AttributeKeyApplication, valAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amounts[i].String()),
 			),
 		)
 	}
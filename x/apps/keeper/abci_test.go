@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"github.com/pokt-network/pocket-core/x/apps/types"
+	"github.com/pokt-network/posmint/store/prefix"
 	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"testing"
 )
@@ -41,6 +44,19 @@ func TestBeginBlocker(t *testing.T) {
 	}
 }
 
+func TestBeginBlocker_BackfillParams(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	// a chain started before the usage retention param existed
+	store := prefix.NewStore(context.KVStore(sdk.ParamsKey), append([]byte(types.DefaultParamspace), '/'))
+	store.Delete(types.KeyUsageRetention)
+	assert.False(t, keeper.Paramstore.Has(context, types.KeyUsageRetention))
+	// the param is backfilled before the usages are pruned
+	BeginBlocker(context, abci.RequestBeginBlock{}, keeper)
+	assert.Equal(t, types.DefaultUsageRetention, keeper.UsageRetention(context))
+	// the params already set are kept
+	assert.Equal(t, types.DefaultParams().MaxApplications, keeper.MaxApplications(context))
+}
+
 //func TestEndBlocker(t *testing.T) {
 //	type args struct {
 //		ctx  sdk.Context
@@ -76,3 +92,29 @@ func TestBeginBlocker(t *testing.T) {
 //		})
 //	}
 //}
+
+func TestBeginBlocker_BurnForMisbehaviour(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	keeper.SetApplication(context, application)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, application.Address, application.StakedTokens)
+	expectedBurn := application.StakedTokens.ToDec().Mul(keeper.SlashFractionMisbehaviour(context)).TruncateInt()
+	// the burn is queued until the next begin blocker
+	keeper.BurnForMisbehaviour(context, application.Address)
+	burn, found := keeper.getApplicationBurn(context, application.Address)
+	assert.True(t, found)
+	assert.True(t, expectedBurn.Equal(burn))
+	stored, _ := keeper.GetApplication(context, application.Address)
+	assert.True(t, application.StakedTokens.Equal(stored.StakedTokens))
+	// the begin blocker burns the stake and clears the burn
+	BeginBlocker(context, abci.RequestBeginBlock{}, keeper)
+	stored, _ = keeper.GetApplication(context, application.Address)
+	assert.True(t, application.StakedTokens.Sub(expectedBurn).Equal(stored.StakedTokens))
+	_, found = keeper.getApplicationBurn(context, application.Address)
+	assert.False(t, found)
+	// an unstaked application is not burned
+	keeper.BurnForMisbehaviour(context, getUnstakedApplication().Address)
+	_, found = keeper.getApplicationBurn(context, getUnstakedApplication().Address)
+	assert.False(t, found)
+}
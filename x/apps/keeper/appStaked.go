@@ -77,3 +77,37 @@ func (k Keeper) IterateAndExecuteOverStakedApps(
 		}
 	}
 }
+
+// set the pending edit of a staked application, replaced by any later edit within the session
+func (k Keeper) SetAppEdit(ctx sdk.Ctx, edit types.AppEdit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyForAppEdit(edit.Address), types.MustMarshalAppEdit(k.cdc, edit))
+}
+
+// get the pending edit of a staked application
+func (k Keeper) GetAppEdit(ctx sdk.Ctx, addr sdk.Address) (edit types.AppEdit, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyForAppEdit(addr))
+	if bz == nil {
+		return edit, false
+	}
+	return types.MustUnmarshalAppEdit(k.cdc, bz), true
+}
+
+// get all of the pending edits of the staked applications
+func (k Keeper) GetAllAppEdits(ctx sdk.Ctx) (edits []types.AppEdit) {
+	edits = make([]types.AppEdit, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingAppEditKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		edits = append(edits, types.MustUnmarshalAppEdit(k.cdc, iterator.Value()))
+	}
+	return edits
+}
+
+// delete the pending edit of an application
+func (k Keeper) deleteAppEdit(ctx sdk.Ctx, addr sdk.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyForAppEdit(addr))
+}
@@ -69,6 +69,160 @@ func (k Keeper) StakeApplication(ctx sdk.Ctx, application types.Application, amo
 	return nil
 }
 
+// validate check called before a staked application unstakes part of its stake
+func (k Keeper) ValidateApplicationPartialUnstake(ctx sdk.Ctx, application types.Application, amount sdk.Int) sdk.Error {
+	// must be staked to partially unstake
+	if !application.IsStaked() {
+		return types.ErrApplicationStatus(k.codespace)
+	}
+	if application.IsJailed() {
+		return types.ErrApplicationJailed(k.codespace)
+	}
+	if !amount.IsPositive() {
+		return types.ErrBadStakeAmount(k.codespace)
+	}
+	// the current stake includes the edits pending within this session
+	stake := application.StakedTokens
+	if edit, found := k.GetAppEdit(ctx, application.Address); found {
+		stake = edit.StakeAfter(stake)
+	}
+	// the application must stay above the minimum, otherwise it must begin unstaking
+	if stake.Sub(amount).LT(sdk.NewInt(k.MinimumStake(ctx))) {
+		return types.ErrUnstakeBelowMinimum(k.codespace)
+	}
+	return nil
+}
+
+// store ops when a staked application unstakes part of its stake -> the unstake is applied at the next session
+// so the relays of the application are unchanged within a session, the tokens are released after the unstaking time
+func (k Keeper) PartialUnstakeApplication(ctx sdk.Ctx, application types.Application, amount sdk.Int) {
+	edit, found := k.GetAppEdit(ctx, application.Address)
+	if !found {
+		edit = types.AppEdit{Address: application.Address, Chains: application.Chains, ChainWeights: application.ChainWeights, AddedTokens: sdk.ZeroInt(), ExcludedNodes: application.ExcludedNodes}
+	}
+	edit.UnstakedTokens = edit.GetUnstakedTokens().Add(amount)
+	k.SetAppEdit(ctx, edit)
+	ctx.Logger().Info("Partially unstaked " + amount.String() + " from application " + application.Address.String() + ", applied at the next session")
+}
+
+// validate check called before a staked application edits its stake, the amount is the new total stake
+func (k Keeper) ValidateEditStake(ctx sdk.Ctx, application types.Application, amount sdk.Int) sdk.Error {
+	// must be staked to edit
+	if !application.IsStaked() {
+		return types.ErrApplicationStatus(k.codespace)
+	}
+	if application.IsJailed() {
+		return types.ErrApplicationJailed(k.codespace)
+	}
+	// the current stake includes the edits pending within this session
+	stake := application.StakedTokens
+	if edit, found := k.GetAppEdit(ctx, application.Address); found {
+		stake = edit.StakeAfter(stake)
+	}
+	diff := amount.Sub(stake)
+	if diff.IsNegative() {
+		return types.ErrStakeDecrease(k.codespace)
+	}
+	coin := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), diff))
+	if !k.AccountsKeeper.HasCoins(ctx, application.Address, coin) {
+		return types.ErrNotEnoughCoins(k.codespace)
+	}
+	return nil
+}
+
+// store ops when a staked application edits its stake -> the edit is applied at the next session
+func (k Keeper) EditStakeApplication(ctx sdk.Ctx, currentApp, application types.Application, amount sdk.Int) sdk.Error {
+	edit, found := k.GetAppEdit(ctx, currentApp.Address)
+	if !found {
+		edit = types.AppEdit{Address: currentApp.Address, AddedTokens: sdk.ZeroInt(), ExcludedNodes: currentApp.ExcludedNodes}
+	}
+	diff := amount.Sub(edit.StakeAfter(currentApp.StakedTokens))
+	// send the added coins to the staked module account, they are held until the edit is applied
+	if diff.IsPositive() {
+		if err := k.coinsFromUnstakedToStaked(ctx, currentApp, diff); err != nil {
+			return sdk.ErrInternal(err.Error())
+		}
+	}
+	edit.Chains = application.Chains
+	edit.ChainWeights = application.ChainWeights
+	edit.AddedTokens = edit.AddedTokens.Add(diff)
+	k.SetAppEdit(ctx, edit)
+	ctx.Logger().Info("Edited stake of application " + currentApp.Address.String() + ", applied at the next session")
+	return nil
+}
+
+// validate check called before a staked application replaces the nodes excluded from its sessions
+func (k Keeper) ValidateExcludeNodes(ctx sdk.Ctx, application types.Application, excludedNodes []sdk.Address) sdk.Error {
+	// must be staked to exclude nodes
+	if !application.IsStaked() {
+		return types.ErrApplicationStatus(k.codespace)
+	}
+	if int64(len(excludedNodes)) > k.MaxExcludedNodes(ctx) {
+		return types.ErrTooManyExcludedNodes(k.codespace)
+	}
+	return types.ValidateExcludedNodes(excludedNodes)
+}
+
+// store ops when a staked application replaces its excluded nodes -> the edit is applied at the next session
+// so every session of the application is generated with the same exclusions
+func (k Keeper) ExcludeNodesApplication(ctx sdk.Ctx, application types.Application, excludedNodes []sdk.Address) {
+	edit, found := k.GetAppEdit(ctx, application.Address)
+	if !found {
+		edit = types.AppEdit{Address: application.Address, Chains: application.Chains, ChainWeights: application.ChainWeights, AddedTokens: sdk.ZeroInt()}
+	}
+	edit.ExcludedNodes = excludedNodes
+	k.SetAppEdit(ctx, edit)
+	ctx.Logger().Info("Edited the excluded nodes of application " + application.Address.String() + ", applied at the next session")
+}
+
+// apply all of the pending edits -> called in the end blocker the block before a session block
+// so the application is unchanged within a session
+func (k Keeper) applyAppEdits(ctx sdk.Ctx) {
+	for _, edit := range k.GetAllAppEdits(ctx) {
+		k.deleteAppEdit(ctx, edit.Address)
+		application, found := k.GetApplication(ctx, edit.Address)
+		if !found || application.IsUnstaked() {
+			// the application is gone, return the added tokens
+			coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), edit.AddedTokens))
+			if err := k.AccountsKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, edit.Address, coins); err != nil {
+				panic(err)
+			}
+			continue
+		}
+		// remove the old power index entry before the tokens change
+		k.deleteApplicationFromStakingSet(ctx, application)
+		application.Chains = edit.Chains
+		application.ChainWeights = edit.ChainWeights
+		application.ExcludedNodes = edit.ExcludedNodes
+		application = application.AddStakedTokens(edit.AddedTokens)
+		// the partially unstaked tokens stay in the staked pool until released
+		if unstaked := sdk.MinInt(edit.GetUnstakedTokens(), application.StakedTokens); unstaked.IsPositive() {
+			application = application.RemoveStakedTokens(unstaked)
+			k.SetPartialUnstake(ctx, types.PartialUnstake{
+				Address:        application.Address,
+				Amount:         unstaked,
+				CompletionTime: ctx.BlockHeader().Time.Add(k.UnStakingTime(ctx)),
+			})
+			ctx.Logger().Info("Began partial unstake of " + unstaked.String() + " from application " + application.Address.String())
+		}
+		// recalculate relays
+		application.MaxRelays = k.CalculateAppRelays(ctx, application)
+		k.SetApplication(ctx, application)
+		if application.IsStaked() {
+			k.SetStakedApplication(ctx, application)
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteEditStake,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyApplication, application.Address.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, application.StakedTokens.String()),
+			),
+		)
+		ctx.Logger().Info("Applied the stake edit of application " + application.Address.String())
+	}
+}
+
 func (k Keeper) ValidateApplicationBeginUnstaking(ctx sdk.Ctx, application types.Application) sdk.Error {
 	// must be staked to begin unstaking
 	if !application.IsStaked() {
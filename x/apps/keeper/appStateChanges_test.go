@@ -248,3 +248,239 @@ func TestAppStateChange_BeginUnstakingApplication(t *testing.T) {
 		})
 	}
 }
+
+func TestAppStateChange_ValidateApplicationPartialUnstake(t *testing.T) {
+	tests := []struct {
+		name        string
+		application types.Application
+		amount      sdk.Int
+		want        sdk.Error
+	}{
+		{
+			name:        "validates partial unstake",
+			application: getStakedApplication(),
+			amount:      sdk.NewInt(100000000000 - types.DefaultMinStake),
+			want:        nil,
+		},
+		{
+			name:        "errors if application not staked",
+			application: getUnstakingApplication(),
+			amount:      sdk.NewInt(1),
+			want:        types.ErrApplicationStatus("apps"),
+		},
+		{
+			name:        "errors if amount is not positive",
+			application: getStakedApplication(),
+			amount:      sdk.ZeroInt(),
+			want:        types.ErrBadStakeAmount("apps"),
+		},
+		{
+			name:        "errors if the stake left is below the minimum",
+			application: getStakedApplication(),
+			amount:      sdk.NewInt(100000000000 - types.DefaultMinStake + 1),
+			want:        types.ErrUnstakeBelowMinimum("apps"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			context, _, keeper := createTestInput(t, true)
+			if got := keeper.ValidateApplicationPartialUnstake(context, tt.application, tt.amount); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AppStateChange.ValidateApplicationPartialUnstake() = got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppStateChange_PartialUnstakeApplication(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	amount := sdk.NewInt(40000000000)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	keeper.SetApplication(context, application)
+	keeper.SetStakedApplication(context, application)
+	keeper.PartialUnstakeApplication(context, application, amount)
+	// the stake is unchanged within the session
+	got, _ := keeper.GetApplication(context, application.Address)
+	if !got.StakedTokens.Equal(application.StakedTokens) || len(keeper.GetAllPartialUnstakes(context)) != 0 {
+		t.Errorf("AppStateChanges.PartialUnstakeApplication() = applied before the next session, got %v staked tokens", got.StakedTokens)
+	}
+	// a second partial unstake may not go below the minimum with the pending one
+	if err := keeper.ValidateApplicationPartialUnstake(context, application, application.StakedTokens.Sub(amount)); err == nil {
+		t.Errorf("AppStateChanges.ValidateApplicationPartialUnstake() = pending partial unstake not counted")
+	}
+	keeper.applyAppEdits(context)
+	got, found := keeper.GetApplication(context, application.Address)
+	if !found {
+		t.Fatalf("AppStateChanges.PartialUnstakeApplication() = application not found")
+	}
+	if !got.IsStaked() || !got.StakedTokens.Equal(application.StakedTokens.Sub(amount)) {
+		t.Errorf("AppStateChanges.PartialUnstakeApplication() = got %v staked tokens, want %v", got.StakedTokens, application.StakedTokens.Sub(amount))
+	}
+	if !got.MaxRelays.Equal(keeper.CalculateAppRelays(context, got)) {
+		t.Errorf("AppStateChanges.PartialUnstakeApplication() = max relays not recalculated, got %v", got.MaxRelays)
+	}
+	partialUnstakes := keeper.GetAllPartialUnstakes(context)
+	if len(partialUnstakes) != 1 || !partialUnstakes[0].Amount.Equal(amount) {
+		t.Fatalf("AppStateChanges.PartialUnstakeApplication() = got partial unstakes %v", partialUnstakes)
+	}
+	// not released before the unstaking time
+	keeper.unstakeAllMaturePartialUnstakes(context)
+	if balance := keeper.AccountsKeeper.GetCoins(context, application.Address).AmountOf(keeper.StakeDenom(context)); !balance.IsZero() {
+		t.Errorf("AppStateChanges.PartialUnstakeApplication() = released before the unstaking time, balance %v", balance)
+	}
+	context = context.WithBlockTime(partialUnstakes[0].CompletionTime)
+	keeper.unstakeAllMaturePartialUnstakes(context)
+	if balance := keeper.AccountsKeeper.GetCoins(context, application.Address).AmountOf(keeper.StakeDenom(context)); !balance.Equal(amount) {
+		t.Errorf("AppStateChanges.PartialUnstakeApplication() = got balance %v, want %v", balance, amount)
+	}
+	if len(keeper.GetAllPartialUnstakes(context)) != 0 {
+		t.Errorf("AppStateChanges.PartialUnstakeApplication() = partial unstake not removed from the queue")
+	}
+}
+
+func TestAppStateChange_ValidateEditStake(t *testing.T) {
+	tests := []struct {
+		name        string
+		application types.Application
+		amount      sdk.Int
+		want        sdk.Error
+	}{
+		{
+			name:        "validates stake top up",
+			application: getStakedApplication(),
+			amount:      sdk.NewInt(100000000001),
+			want:        nil,
+		},
+		{
+			name:        "validates chains only edit",
+			application: getStakedApplication(),
+			amount:      sdk.NewInt(100000000000),
+			want:        nil,
+		},
+		{
+			name:        "errors if application not staked",
+			application: getUnstakingApplication(),
+			amount:      sdk.NewInt(100000000001),
+			want:        types.ErrApplicationStatus("apps"),
+		},
+		{
+			name:        "errors if the stake decreases",
+			application: getStakedApplication(),
+			amount:      sdk.NewInt(99999999999),
+			want:        types.ErrStakeDecrease("apps"),
+		},
+		{
+			name:        "errors if not enough coins",
+			application: getStakedApplication(),
+			amount:      sdk.NewInt(300000000000),
+			want:        types.ErrNotEnoughCoins("apps"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			context, _, keeper := createTestInput(t, true)
+			addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+			sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, tt.application.Address, sdk.NewInt(100000000000))
+			if got := keeper.ValidateEditStake(context, tt.application, tt.amount); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AppStateChange.ValidateEditStake() = got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppStateChange_EditStakeApplication(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, application.Address, sdk.NewInt(100000000000))
+	keeper.SetApplication(context, application)
+	keeper.SetStakedApplication(context, application)
+	edited := application
+	edited.Chains = []string{"0002", "0003"}
+	edited.ChainWeights = []types.ChainWeight{{Chain: "0002", Weight: 3}, {Chain: "0003", Weight: 1}}
+	amount := application.StakedTokens.Add(sdk.NewInt(30000000000))
+	if err := keeper.EditStakeApplication(context, application, edited, amount); err != nil {
+		t.Fatalf("AppStateChanges.EditStakeApplication() = unexpected error %v", err)
+	}
+	// a second edit within the session adds to the pending edit
+	amount = amount.Add(sdk.NewInt(10000000000))
+	if err := keeper.ValidateEditStake(context, application, amount.Sub(sdk.NewInt(20000000000))); err == nil {
+		t.Errorf("AppStateChanges.ValidateEditStake() = the pending edit is not part of the stake")
+	}
+	if err := keeper.EditStakeApplication(context, application, edited, amount); err != nil {
+		t.Fatalf("AppStateChanges.EditStakeApplication() = unexpected error %v", err)
+	}
+	if balance := keeper.AccountsKeeper.GetCoins(context, application.Address).AmountOf(keeper.StakeDenom(context)); !balance.Equal(sdk.NewInt(60000000000)) {
+		t.Errorf("AppStateChanges.EditStakeApplication() = got balance %v, want %v", balance, sdk.NewInt(60000000000))
+	}
+	// unchanged within the session
+	got, _ := keeper.GetApplication(context, application.Address)
+	if !got.StakedTokens.Equal(application.StakedTokens) || !reflect.DeepEqual(got.Chains, application.Chains) {
+		t.Errorf("AppStateChanges.EditStakeApplication() = applied before the session block, got %v", got)
+	}
+	keeper.applyAppEdits(context)
+	got, _ = keeper.GetApplication(context, application.Address)
+	if !got.StakedTokens.Equal(amount) {
+		t.Errorf("AppStateChanges.EditStakeApplication() = got %v staked tokens, want %v", got.StakedTokens, amount)
+	}
+	if !reflect.DeepEqual(got.Chains, edited.Chains) || !reflect.DeepEqual(got.ChainWeights, edited.ChainWeights) {
+		t.Errorf("AppStateChanges.EditStakeApplication() = got chains %v %v, want %v %v", got.Chains, got.ChainWeights, edited.Chains, edited.ChainWeights)
+	}
+	if !got.MaxRelays.Equal(keeper.CalculateAppRelays(context, got)) {
+		t.Errorf("AppStateChanges.EditStakeApplication() = max relays not recalculated, got %v", got.MaxRelays)
+	}
+	if len(keeper.GetAllAppEdits(context)) != 0 {
+		t.Errorf("AppStateChanges.EditStakeApplication() = edit not removed")
+	}
+	if staked := keeper.getStakedApplications(context); len(staked) != 1 || !staked[0].StakedTokens.Equal(amount) {
+		t.Errorf("AppStateChanges.EditStakeApplication() = staking set not updated, got %v", staked)
+	}
+}
+
+func TestAppStateChange_ExcludeNodesApplication(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	keeper.SetApplication(context, application)
+	keeper.SetStakedApplication(context, application)
+	excludedNodes := []sdk.Address{getRandomApplicationAddress(), getRandomApplicationAddress()}
+	if err := keeper.ValidateExcludeNodes(context, application, excludedNodes); err != nil {
+		t.Fatalf("AppStateChanges.ValidateExcludeNodes() = unexpected error %v", err)
+	}
+	// bounded by the max excluded nodes
+	tooMany := make([]sdk.Address, keeper.MaxExcludedNodes(context)+1)
+	for i := range tooMany {
+		tooMany[i] = getRandomApplicationAddress()
+	}
+	if err := keeper.ValidateExcludeNodes(context, application, tooMany); !reflect.DeepEqual(err, types.ErrTooManyExcludedNodes(keeper.Codespace())) {
+		t.Errorf("AppStateChanges.ValidateExcludeNodes() = got %v, want %v", err, types.ErrTooManyExcludedNodes(keeper.Codespace()))
+	}
+	if err := keeper.ValidateExcludeNodes(context, getUnstakingApplication(), excludedNodes); !reflect.DeepEqual(err, types.ErrApplicationStatus(keeper.Codespace())) {
+		t.Errorf("AppStateChanges.ValidateExcludeNodes() = got %v, want %v", err, types.ErrApplicationStatus(keeper.Codespace()))
+	}
+	keeper.ExcludeNodesApplication(context, application, excludedNodes)
+	// unchanged within the session
+	got, _ := keeper.GetApplication(context, application.Address)
+	if len(got.ExcludedNodes) != 0 {
+		t.Errorf("AppStateChanges.ExcludeNodesApplication() = applied before the session block, got %v", got.ExcludedNodes)
+	}
+	keeper.applyAppEdits(context)
+	got, _ = keeper.GetApplication(context, application.Address)
+	if !reflect.DeepEqual(got.ExcludedNodes, excludedNodes) {
+		t.Errorf("AppStateChanges.ExcludeNodesApplication() = got %v, want %v", got.ExcludedNodes, excludedNodes)
+	}
+	if !got.StakedTokens.Equal(application.StakedTokens) || !reflect.DeepEqual(got.Chains, application.Chains) {
+		t.Errorf("AppStateChanges.ExcludeNodesApplication() = the stake changed, got %v", got)
+	}
+	// a stake edit keeps the excluded nodes
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, application.Address, sdk.NewInt(100000000000))
+	amount := got.StakedTokens.Add(sdk.NewInt(10000000000))
+	if err := keeper.EditStakeApplication(context, got, got, amount); err != nil {
+		t.Fatalf("AppStateChanges.EditStakeApplication() = unexpected error %v", err)
+	}
+	keeper.applyAppEdits(context)
+	got, _ = keeper.GetApplication(context, application.Address)
+	if !reflect.DeepEqual(got.ExcludedNodes, excludedNodes) {
+		t.Errorf("AppStateChanges.EditStakeApplication() = got excluded nodes %v, want %v", got.ExcludedNodes, excludedNodes)
+	}
+}
@@ -115,3 +115,93 @@ func (k Keeper) unstakeAllMatureApplications(ctx sdk.Ctx) {
 		store.Delete(unstakingApplicationsIterator.Key())
 	}
 }
+
+// set a partial unstake in the queue, adding to the amount of an entry with the same completion time
+func (k Keeper) SetPartialUnstake(ctx sdk.Ctx, pu types.PartialUnstake) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyForPartialUnstake(pu.CompletionTime, pu.Address)
+	if bz := store.Get(key); bz != nil {
+		pu.Amount = pu.Amount.Add(types.MustUnmarshalPartialUnstake(k.cdc, bz).Amount)
+	}
+	store.Set(key, types.MustMarshalPartialUnstake(k.cdc, pu))
+}
+
+// get all of the partial unstakes sorted by completion time
+func (k Keeper) GetAllPartialUnstakes(ctx sdk.Ctx) (pus []types.PartialUnstake) {
+	pus = make([]types.PartialUnstake, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PartialUnstakingKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		pus = append(pus, types.MustUnmarshalPartialUnstake(k.cdc, iterator.Value()))
+	}
+	return pus
+}
+
+// release the tokens of all the mature partial unstakes -> called in the end blocker
+func (k Keeper) unstakeAllMaturePartialUnstakes(ctx sdk.Ctx) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.PartialUnstakingKey, sdk.PrefixEndBytes(types.KeyForPartialUnstakes(ctx.BlockHeader().Time)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		pu := types.MustUnmarshalPartialUnstake(k.cdc, iterator.Value())
+		coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), pu.Amount))
+		if err := k.AccountsKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, pu.Address, coins); err != nil {
+			panic(err)
+		}
+		store.Delete(iterator.Key())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompletePartialUnstake,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyApplication, pu.Address.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, pu.Amount.String()),
+			),
+		)
+		ctx.Logger().Info("Finished partial unstake of " + pu.Amount.String() + " from application " + pu.Address.String())
+	}
+}
+
+// get the unstaking schedule of all the applications, or of one if the address is not empty, sorted by completion time
+func (k Keeper) GetUnstakingSchedule(ctx sdk.Ctx, addr sdk.Address) []types.UnstakingEntry {
+	entries := make([]types.UnstakingEntry, 0)
+	// the pending partial unstakes begin unstaking the block before the next session block, the completion time is only
+	// known once they begin so it is left unset
+	frequency := k.POSKeeper.SessionBlockFrequency(ctx)
+	beginHeight := (ctx.BlockHeight()/frequency + 1) * frequency
+	for _, edit := range k.GetAllAppEdits(ctx) {
+		if !edit.GetUnstakedTokens().IsPositive() || (len(addr) != 0 && !edit.Address.Equals(addr)) {
+			continue
+		}
+		entries = append(entries, types.UnstakingEntry{
+			Address:     edit.Address,
+			Amount:      edit.GetUnstakedTokens(),
+			Partial:     true,
+			Waiting:     true,
+			BeginHeight: beginHeight,
+		})
+	}
+	for _, application := range k.getAllUnstakingApplications(ctx) {
+		if len(addr) != 0 && !application.Address.Equals(addr) {
+			continue
+		}
+		entries = append(entries, types.UnstakingEntry{
+			Address:        application.Address,
+			Amount:         application.StakedTokens,
+			CompletionTime: application.UnstakingCompletionTime,
+		})
+	}
+	for _, pu := range k.GetAllPartialUnstakes(ctx) {
+		if len(addr) != 0 && !pu.Address.Equals(addr) {
+			continue
+		}
+		entries = append(entries, types.UnstakingEntry{
+			Address:        pu.Address,
+			Amount:         pu.Amount,
+			CompletionTime: pu.CompletionTime,
+			Partial:        true,
+		})
+	}
+	types.SortUnstakingEntries(entries)
+	return entries
+}
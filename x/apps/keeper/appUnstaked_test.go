@@ -5,6 +5,7 @@ import (
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestAppUnstaked_GetAndSetlUnstaking(t *testing.T) {
@@ -281,3 +282,41 @@ func TestAppUnstaked_UnstakingApplicationsIterator(t *testing.T) {
 		})
 	}
 }
+
+func TestAppUnstaked_GetUnstakingSchedule(t *testing.T) {
+	unstaking := getUnstakingApplication()
+	unstaking.UnstakingCompletionTime = time.Unix(200, 0).UTC()
+	other := getStakedApplication()
+	context, _, keeper := createTestInput(t, true)
+	keeper.SetApplication(context, unstaking)
+	keeper.SetUnstakingApplication(context, unstaking)
+	keeper.SetPartialUnstake(context, types.PartialUnstake{Address: other.Address, Amount: sdk.NewInt(10), CompletionTime: time.Unix(100, 0).UTC()})
+	// all the applications, sorted by completion time
+	schedule := keeper.GetUnstakingSchedule(context, sdk.Address{})
+	assert.Len(t, schedule, 2)
+	assert.True(t, schedule[0].Partial)
+	assert.Equal(t, other.Address, schedule[0].Address)
+	assert.Equal(t, sdk.NewInt(10), schedule[0].Amount)
+	assert.False(t, schedule[1].Partial)
+	assert.Equal(t, unstaking.StakedTokens, schedule[1].Amount)
+	assert.True(t, unstaking.UnstakingCompletionTime.Equal(schedule[1].CompletionTime))
+	// a single application
+	schedule = keeper.GetUnstakingSchedule(context, unstaking.Address)
+	assert.Len(t, schedule, 1)
+	assert.Equal(t, unstaking.Address, schedule[0].Address)
+	// an application not unstaking
+	assert.Empty(t, keeper.GetUnstakingSchedule(context, getRandomApplicationAddress()))
+	// a partial unstake waiting for the next session
+	keeper.SetApplication(context, other)
+	keeper.PartialUnstakeApplication(context, other, sdk.NewInt(20))
+	schedule = keeper.GetUnstakingSchedule(context, other.Address)
+	assert.Len(t, schedule, 2)
+	assert.False(t, schedule[0].Waiting)
+	// the waiting partial unstake comes last, it has no completion time until it begins unstaking
+	assert.True(t, schedule[1].Waiting)
+	assert.True(t, schedule[1].Partial)
+	assert.Equal(t, sdk.NewInt(20), schedule[1].Amount)
+	assert.True(t, schedule[1].CompletionTime.IsZero())
+	frequency := keeper.POSKeeper.SessionBlockFrequency(context)
+	assert.Equal(t, (context.BlockHeight()/frequency+1)*frequency, schedule[1].BeginHeight)
+}
@@ -88,3 +88,27 @@ func (k Keeper) CalculateAppRelays(ctx sdk.Ctx, application types.Application) s
 	baselineThroughput := basePercentage.Mul(application.StakedTokens.ToDec())
 	return participationRate.Mul(baselineThroughput).Add(stakingAdjustment).TruncateInt()
 }
+
+// recalculate the relays of every staked application, so the throughput follows the current params and stake
+// -> called in the end blocker the block before a session block
+func (k Keeper) recalculateAllAppRelays(ctx sdk.Ctx) {
+	for _, application := range k.GetAllApplications(ctx) {
+		if !application.IsStaked() {
+			continue
+		}
+		maxRelays := k.CalculateAppRelays(ctx, application)
+		if maxRelays.Equal(application.MaxRelays) {
+			continue
+		}
+		application.MaxRelays = maxRelays
+		k.SetApplication(ctx, application)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUpdateMaxRelays,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyApplication, application.Address.String()),
+				sdk.NewAttribute(types.AttributeKeyMaxRelays, maxRelays.String()),
+			),
+		)
+	}
+}
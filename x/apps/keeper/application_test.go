@@ -66,6 +66,30 @@ func TestApplication_CalculateAppRelays(t *testing.T) {
 	}
 }
 
+func TestApplication_RecalculateAllAppRelays(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	application.MaxRelays = keeper.CalculateAppRelays(context, application)
+	keeper.SetApplication(context, application)
+	unstaking := getUnstakingApplication()
+	keeper.SetApplication(context, unstaking)
+	// the throughput params change after staking
+	params := keeper.GetParams(context)
+	params.BaseRelaysPerPOKT = params.BaseRelaysPerPOKT * 2
+	keeper.SetParams(context, params)
+	keeper.recalculateAllAppRelays(context)
+	got, _ := keeper.GetApplication(context, application.Address)
+	if want := keeper.CalculateAppRelays(context, application); !got.MaxRelays.Equal(want) || got.MaxRelays.Equal(application.MaxRelays) {
+		t.Errorf("Application.recalculateAllAppRelays() = got %v, want %v", got.MaxRelays, want)
+	}
+	if got, _ := keeper.GetApplication(context, unstaking.Address); !got.MaxRelays.Equal(unstaking.MaxRelays) {
+		t.Errorf("Application.recalculateAllAppRelays() = recalculated an unstaking application, got %v", got.MaxRelays)
+	}
+	if events := context.EventManager().Events(); len(events) != 1 || events[0].Type != types.EventTypeUpdateMaxRelays {
+		t.Errorf("Application.recalculateAllAppRelays() = got events %v", events)
+	}
+}
+
 func TestApplication_GetAllAplications(t *testing.T) {
 	application := getStakedApplication()
 
@@ -38,6 +38,18 @@ func (k Keeper) StakingAdjustment(ctx sdk.Ctx) (adjustment int64) {
 	return
 }
 
+// UsageRetention - how many blocks the relay usage of the applications is kept
+func (k Keeper) UsageRetention(ctx sdk.Ctx) (res int64) {
+	k.Paramstore.Get(ctx, types.KeyUsageRetention, &res)
+	return
+}
+
+// SlashFractionMisbehaviour - the fraction of the stake burned when an application misbehaves
+func (k Keeper) SlashFractionMisbehaviour(ctx sdk.Ctx) (res sdk.Dec) {
+	k.Paramstore.Get(ctx, types.KeySlashFractionMisbehaviour, &res)
+	return
+}
+
 // MaxApplications - Maximum number of applications
 func (k Keeper) MaxApplications(ctx sdk.Ctx) (res uint64) {
 	k.Paramstore.Get(ctx, types.KeyMaxApplications, &res)
@@ -49,15 +61,24 @@ func (k Keeper) MinimumStake(ctx sdk.Ctx) (res int64) {
 	return
 }
 
+// MaxExcludedNodes - the maximum number of nodes an application may exclude from its sessions
+func (k Keeper) MaxExcludedNodes(ctx sdk.Ctx) (res int64) {
+	k.Paramstore.Get(ctx, types.KeyMaxExcludedNodes, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 	return types.Params{
-		UnstakingTime:       k.UnStakingTime(ctx),
-		MaxApplications:     k.MaxApplications(ctx),
-		AppStakeMin:         k.MinimumStake(ctx),
-		BaseRelaysPerPOKT:   k.BaselineThroughputStakeRate(ctx),
-		ParticipationRateOn: k.ParticipationRateOn(ctx),
-		StabilityAdjustment: k.StakingAdjustment(ctx),
+		UnstakingTime:             k.UnStakingTime(ctx),
+		MaxApplications:           k.MaxApplications(ctx),
+		AppStakeMin:               k.MinimumStake(ctx),
+		BaseRelaysPerPOKT:         k.BaselineThroughputStakeRate(ctx),
+		ParticipationRateOn:       k.ParticipationRateOn(ctx),
+		StabilityAdjustment:       k.StakingAdjustment(ctx),
+		UsageRetention:            k.UsageRetention(ctx),
+		SlashFractionMisbehaviour: k.SlashFractionMisbehaviour(ctx),
+		MaxExcludedNodes:          k.MaxExcludedNodes(ctx),
 	}
 }
 
@@ -65,3 +86,13 @@ func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 func (k Keeper) SetParams(ctx sdk.Ctx, params types.Params) {
 	k.Paramstore.SetParamSet(ctx, &params)
 }
+
+// backfill the default of every param missing from the world state, for the params added after the chain started
+func (k Keeper) BackfillParams(ctx sdk.Ctx) {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !k.Paramstore.Has(ctx, pair.Key) {
+			k.Paramstore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
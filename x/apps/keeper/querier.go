@@ -29,6 +29,10 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryStakedPool(ctx, k)
 		case types.QueryAppUnstakedPool:
 			return queryUnstakedPool(ctx, k)
+		case types.QueryAppUsage:
+			return queryAppUsage(ctx, req, k)
+		case types.QueryUnstakingSchedule:
+			return queryUnstakingSchedule(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -41,8 +45,12 @@ func queryApplications(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sd
 	if err != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
-	applications := k.GetAllApplications(ctx)
-	start, end := util.Paginate(len(applications), params.Page, params.Limit, int(k.GetParams(ctx).MaxApplications))
+	if err := params.Validate(); err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+	applications := params.Filter(k.GetAllApplications(ctx))
+	// every matching application by default, the maximum applications may overflow an int
+	start, end := util.Paginate(len(applications), params.Page, params.Limit, len(applications))
 	if start < 0 || end < 0 {
 		applications = []types.Application{}
 	} else {
@@ -171,3 +179,31 @@ func queryParameters(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
 	}
 	return res, nil
 }
+
+func queryAppUsage(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAppUsageParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	usage := k.GetAppUsage(ctx, params.Address, params.Chain)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, usage)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryUnstakingSchedule(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAppParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	entries := k.GetUnstakingSchedule(ctx, params.Address)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, entries)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
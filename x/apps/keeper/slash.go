@@ -13,6 +13,26 @@ func (k Keeper) BurnApplication(ctx sdk.Ctx, address sdk.Address, amount sdk.Int
 	k.setApplicationBurn(ctx, newSeverity, address)
 }
 
+// queue a burn of the misbehaviour slash fraction of the application stake, executed on the next begin blocker
+func (k Keeper) BurnForMisbehaviour(ctx sdk.Ctx, address sdk.Address) {
+	application, found := k.GetApplication(ctx, address)
+	if !found {
+		ctx.Logger().Error("application trying to burn for misbehaviour, not found: possibly force unstaked?")
+		return
+	}
+	// an unstaking application may be unstaked before the burn is executed
+	if !application.IsStaked() {
+		ctx.Logger().Error("application trying to burn for misbehaviour, not staked: " + application.Address.String())
+		return
+	}
+	coins := application.StakedTokens.ToDec().Mul(k.SlashFractionMisbehaviour(ctx)).TruncateInt()
+	if !coins.IsPositive() {
+		return
+	}
+	k.BurnApplication(ctx, application.Address, coins)
+	ctx.Logger().Info("Misbehaviour burn set for " + application.Address.String() + " with a severity of " + coins.String())
+}
+
 // simpleSlash a application for an infraction committed at a known height
 // Find the contributing stake at that height and burn the specified slashFactor
 func (k Keeper) simpleSlash(ctx sdk.Ctx, consAddr sdk.Address, amount sdk.Int) {
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/pokt-network/pocket-core/x/apps/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// set a relay usage and its session index in the store
+func (k Keeper) SetUsage(ctx sdk.Ctx, usage types.Usage) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyForUsage(usage.Address, usage.SessionHeight, usage.Chain), types.MustMarshalUsage(k.cdc, usage))
+	store.Set(types.KeyForUsageBySession(usage.SessionHeight, usage.Address, usage.Chain), []byte{})
+}
+
+// get the relay usage of an application for a chain in a session
+func (k Keeper) GetUsage(ctx sdk.Ctx, address sdk.Address, sessionHeight int64, chain string) (usage types.Usage, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyForUsage(address, sessionHeight, chain))
+	if bz == nil {
+		return usage, false
+	}
+	return types.MustUnmarshalUsage(k.cdc, bz), true
+}
+
+// return the retained relay usage of an application, sorted by session height
+func (k Keeper) GetUsages(ctx sdk.Ctx, address sdk.Address) (usages []types.Usage) {
+	usages = make([]types.Usage, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyForUsages(address))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		usages = append(usages, types.MustUnmarshalUsage(k.cdc, iterator.Value()))
+	}
+	return usages
+}
+
+// return the retained relay usage of an application per session and per chain, optionally for a single chain
+func (k Keeper) GetAppUsage(ctx sdk.Ctx, address sdk.Address, chain string) types.AppUsage {
+	usages := make([]types.Usage, 0)
+	for _, u := range k.GetUsages(ctx, address) {
+		if chain == "" || u.Chain == chain {
+			usages = append(usages, u)
+		}
+	}
+	return types.NewAppUsage(address, usages)
+}
+
+// record the relays claimed or proven for an application session against the max relays of the application for the
+// chain at the session block -> called by the pocketcore module
+func (k Keeper) RecordRelayUsage(ctx sdk.Ctx, address sdk.Address, chain string, sessionHeight int64, maxRelays sdk.Int, claimedRelays, provenRelays int64) {
+	if k.UsageRetention(ctx) == 0 {
+		return
+	}
+	usage, found := k.GetUsage(ctx, address, sessionHeight, chain)
+	if !found {
+		usage = types.Usage{
+			Address:       address,
+			Chain:         chain,
+			SessionHeight: sessionHeight,
+			MaxRelays:     maxRelays,
+		}
+	}
+	usage.ClaimedRelays += claimedRelays
+	usage.ProvenRelays += provenRelays
+	k.SetUsage(ctx, usage)
+}
+
+// delete the relay usage older than the retention, called on begin blocker
+func (k Keeper) pruneUsages(ctx sdk.Ctx) {
+	store := ctx.KVStore(k.storeKey)
+	// the sessions at or below the cutoff height are past the retention
+	cutoff := ctx.BlockHeight() - k.UsageRetention(ctx)
+	if cutoff < 0 {
+		return
+	}
+	iterator := store.Iterator(types.UsageBySessionKey, sdk.PrefixEndBytes(types.KeyForUsagesBySession(cutoff)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// the index key is prefix | session height | address | chain
+		key := iterator.Key()
+		sessionHeight := int64(binary.BigEndian.Uint64(key[1:9]))
+		address := sdk.Address(key[9 : 9+sdk.AddrLen])
+		chain := string(key[9+sdk.AddrLen:])
+		store.Delete(types.KeyForUsage(address, sessionHeight, chain))
+		store.Delete(key)
+	}
+}
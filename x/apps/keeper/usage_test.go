@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestUsage_RecordRelayUsage(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	keeper.SetApplication(context, application)
+	maxRelays := sdk.NewInt(334)
+	// two servicers claim then one proves
+	keeper.RecordRelayUsage(context, application.Address, "0001", 1, maxRelays, 100, 0)
+	keeper.RecordRelayUsage(context, application.Address, "0001", 1, maxRelays, 50, 0)
+	keeper.RecordRelayUsage(context, application.Address, "0001", 1, maxRelays, 0, 100)
+	keeper.RecordRelayUsage(context, application.Address, "0002", 1, maxRelays, 10, 0)
+	keeper.RecordRelayUsage(context, application.Address, "0001", 11, maxRelays, 20, 20)
+	usage, found := keeper.GetUsage(context, application.Address, 1, "0001")
+	assert.True(t, found)
+	assert.Equal(t, int64(150), usage.ClaimedRelays)
+	assert.Equal(t, int64(100), usage.ProvenRelays)
+	// the allowance of the session is recorded, not the current one of the application
+	assert.True(t, usage.MaxRelays.Equal(maxRelays))
+	appUsage := keeper.GetAppUsage(context, application.Address, "")
+	assert.Len(t, appUsage.Sessions, 3)
+	assert.Len(t, appUsage.Chains, 2)
+	assert.Equal(t, "0001", appUsage.Chains[0].Chain)
+	assert.Equal(t, int64(2), appUsage.Chains[0].Sessions)
+	assert.Equal(t, int64(170), appUsage.Chains[0].ClaimedRelays)
+	assert.Equal(t, int64(120), appUsage.Chains[0].ProvenRelays)
+	assert.True(t, appUsage.Chains[0].MaxRelays.Equal(sdk.NewInt(668)))
+	// filtered by chain
+	appUsage = keeper.GetAppUsage(context, application.Address, "0002")
+	assert.Len(t, appUsage.Sessions, 1)
+	assert.Len(t, appUsage.Chains, 1)
+}
+
+func TestUsage_PruneUsages(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	keeper.SetApplication(context, application)
+	keeper.RecordRelayUsage(context, application.Address, "0001", 1, sdk.NewInt(1000), 100, 0)
+	keeper.RecordRelayUsage(context, application.Address, "0001", 11, sdk.NewInt(1000), 100, 0)
+	// the first session is past the retention
+	context = context.WithBlockHeight(1 + keeper.UsageRetention(context))
+	keeper.pruneUsages(context)
+	usages := keeper.GetUsages(context, application.Address)
+	assert.Len(t, usages, 1)
+	assert.Equal(t, int64(11), usages[0].SessionHeight)
+	// no usage is recorded without a retention
+	params := keeper.GetParams(context)
+	params.UsageRetention = 0
+	keeper.SetParams(context, params)
+	keeper.RecordRelayUsage(context, application.Address, "0001", 21, sdk.NewInt(1000), 100, 0)
+	assert.Len(t, keeper.GetUsages(context, application.Address), 1)
+}
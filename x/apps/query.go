@@ -21,15 +21,24 @@ func QueryApplication(cdc *codec.Codec, tmNode client.Client, addr sdk.Address,
 	return types.MustUnmarshalApplication(cdc, res), nil
 }
 
-func QueryApplications(cdc *codec.Codec, tmNode client.Client, height int64) (types.Applications, error) {
+func QueryApplications(cdc *codec.Codec, tmNode client.Client, height int64, opts types.QueryAppsParams) (types.Applications, error) {
 	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
-	resKVs, _, err := cliCtx.QuerySubspace(types.AllApplicationsKey, types.StoreKey)
+	bz, err := cdc.MarshalJSON(opts)
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryApplications), bz)
 	if err != nil {
 		return types.Applications{}, err
 	}
-	applications := make(types.Applications, 0)
-	for _, kv := range resKVs {
-		applications = append(applications, types.MustUnmarshalApplication(cdc, kv.Value))
+	applications := types.Applications{}
+	err = cdc.UnmarshalJSON(res, &applications)
+	if err != nil {
+		return applications, err
+	}
+	// amino decodes an empty page as nil
+	if applications == nil {
+		applications = make(types.Applications, 0)
 	}
 	return applications, nil
 }
@@ -132,3 +141,47 @@ func QueryPOSParams(cdc *codec.Codec, tmNode client.Client, height int64) (types
 	cdc.MustUnmarshalJSON(bz, &params)
 	return params, nil
 }
+
+func QueryAppUsage(cdc *codec.Codec, tmNode client.Client, addr sdk.Address, chain string, height int64) (types.AppUsage, error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.NewQueryAppUsageParams(addr, chain))
+	if err != nil {
+		return types.AppUsage{}, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryAppUsage), bz)
+	if err != nil {
+		return types.AppUsage{}, err
+	}
+	var usage types.AppUsage
+	err = cdc.UnmarshalJSON(res, &usage)
+	if err != nil {
+		return usage, err
+	}
+	// amino decodes an empty list as nil
+	if usage.Sessions == nil {
+		usage.Sessions = make([]types.Usage, 0)
+	}
+	if usage.Chains == nil {
+		usage.Chains = make([]types.ChainUsage, 0)
+	}
+	return usage, nil
+}
+
+func QueryUnstakingSchedule(cdc *codec.Codec, tmNode client.Client, addr sdk.Address, height int64) ([]types.UnstakingEntry, error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.NewQueryAppParams(addr))
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryUnstakingSchedule), bz)
+	if err != nil {
+		return nil, err
+	}
+	var entries []types.UnstakingEntry
+	err = cdc.UnmarshalJSON(res, &entries)
+	if entries == nil {
+		// amino decodes an empty list as nil
+		entries = make([]types.UnstakingEntry, 0)
+	}
+	return entries, err
+}
@@ -12,12 +12,13 @@ import (
 	"github.com/tendermint/tendermint/rpc/client"
 )
 
-func StakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, chains []string, amount sdk.Int, kp keys.KeyPair, passphrase string) (*sdk.TxResponse, error) {
+func StakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, chains []string, chainWeights []types.ChainWeight, amount sdk.Int, kp keys.KeyPair, passphrase string) (*sdk.TxResponse, error) {
 	fromAddr := kp.GetAddress()
 	msg := types.MsgAppStake{
-		PubKey: kp.PublicKey,
-		Value:  amount,
-		Chains: chains, // non native blockchains
+		PubKey:       kp.PublicKey,
+		Value:        amount,
+		Chains:       chains,       // non native blockchains
+		ChainWeights: chainWeights, // optional share of the max relays of each chain
 	}
 	txBuilder, cliCtx := newTx(cdc, msg, fromAddr, tmNode, keybase, passphrase)
 	err := msg.ValidateBasic()
@@ -37,6 +38,26 @@ func UnstakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, add
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
 }
 
+func PartialUnstakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, address sdk.Address, amount sdk.Int, passphrase string) (*sdk.TxResponse, error) {
+	msg := types.MsgAppPartialUnstake{Address: address, Amount: amount}
+	txBuilder, cliCtx := newTx(cdc, msg, address, tmNode, keybase, passphrase)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
+func ExcludeNodesTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, address sdk.Address, excludedNodes []sdk.Address, passphrase string) (*sdk.TxResponse, error) {
+	msg := types.MsgAppExcludeNodes{Address: address, ExcludedNodes: excludedNodes}
+	txBuilder, cliCtx := newTx(cdc, msg, address, tmNode, keybase, passphrase)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
 func newTx(cdc *codec.Codec, msg sdk.Msg, fromAddr sdk.Address, tmNode client.Client, keybase keys.Keybase, passphrase string) (txBuilder auth.TxBuilder, cliCtx util.CLIContext) {
 	genDoc, err := tmNode.Genesis()
 	if err != nil {
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+	"github.com/pokt-network/posmint/codec"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// AppEdit - an edit of a staked application waiting for the next session to be applied
+type AppEdit struct {
+	Address        sdk.Address   `json:"address" yaml:"address"`                 // the application being edited
+	Chains         []string      `json:"chains" yaml:"chains"`                   // the chains replacing the current ones
+	ChainWeights   []ChainWeight `json:"chain_weights" yaml:"chain_weights"`     // the chain weights replacing the current ones
+	AddedTokens    sdk.Int       `json:"added_tokens" yaml:"added_tokens"`       // the tokens added to the stake
+	ExcludedNodes  []sdk.Address `json:"excluded_nodes" yaml:"excluded_nodes"`   // the excluded nodes replacing the current ones
+	UnstakedTokens sdk.Int       `json:"unstaked_tokens" yaml:"unstaked_tokens"` // the tokens partially unstaked from the stake
+}
+
+// HashString returns a human readable string representation of an app edit.
+func (ae AppEdit) String() string {
+	return fmt.Sprintf("Address:\t\t%s\nChains:\t\t\t%v\nAdded Tokens:\t\t%s", ae.Address, ae.Chains, ae.AddedTokens)
+}
+
+// the tokens partially unstaked by the edit, zero if none
+func (ae AppEdit) GetUnstakedTokens() sdk.Int {
+	if ae.UnstakedTokens == (sdk.Int{}) {
+		return sdk.ZeroInt()
+	}
+	return ae.UnstakedTokens
+}
+
+// the stake of the application once the edit is applied
+func (ae AppEdit) StakeAfter(stakedTokens sdk.Int) sdk.Int {
+	return stakedTokens.Add(ae.AddedTokens).Sub(ae.GetUnstakedTokens())
+}
+
+// MUST return the amino encoded version of this app edit
+func MustMarshalAppEdit(cdc *codec.Codec, ae AppEdit) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(ae)
+}
+
+// MUST decode the app edit from the bytes
+func MustUnmarshalAppEdit(cdc *codec.Codec, bz []byte) (ae AppEdit) {
+	cdc.MustUnmarshalBinaryLengthPrefixed(bz, &ae)
+	return
+}
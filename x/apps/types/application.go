@@ -19,6 +19,49 @@ type Application struct {
 	StakedTokens            sdk.Int          `json:"tokens" yaml:"tokens"`                 // tokens staked in the network
 	MaxRelays               sdk.Int          `json:"max_relays" yaml:"max_relays"`         // maximum number of relays allowed
 	UnstakingCompletionTime time.Time        `json:"unstaking_time" yaml:"unstaking_time"` // if unstaking, min time for the application to complete unstaking
+	ChainWeights            []ChainWeight    `json:"chain_weights" yaml:"chain_weights"`   // optional share of the maximum relays allocated to each chain
+	ExcludedNodes           []sdk.Address    `json:"excluded_nodes" yaml:"excluded_nodes"` // nodes only selected for the sessions of the application when no other node is left
+}
+
+// ChainWeight - the relative share of the maximum relays of an application allocated to a chain
+type ChainWeight struct {
+	Chain  string `json:"chain" yaml:"chain"`
+	Weight int64  `json:"weight" yaml:"weight"`
+}
+
+// ValidateChainWeights - the chain weights are optional, if set every staked chain must have exactly one positive weight
+func ValidateChainWeights(chains []string, chainWeights []ChainWeight) sdk.Error {
+	if len(chainWeights) == 0 {
+		return nil
+	}
+	if len(chainWeights) != len(chains) {
+		return ErrInvalidChainWeights(DefaultCodespace)
+	}
+	weighted := make(map[string]bool, len(chainWeights))
+	for _, cw := range chainWeights {
+		if cw.Weight <= 0 || weighted[cw.Chain] {
+			return ErrInvalidChainWeights(DefaultCodespace)
+		}
+		weighted[cw.Chain] = true
+	}
+	for _, chain := range chains {
+		if !weighted[chain] {
+			return ErrInvalidChainWeights(DefaultCodespace)
+		}
+	}
+	return nil
+}
+
+// ValidateExcludedNodes - the excluded nodes must be distinct non empty addresses
+func ValidateExcludedNodes(excludedNodes []sdk.Address) sdk.Error {
+	excluded := make(map[string]bool, len(excludedNodes))
+	for _, addr := range excludedNodes {
+		if addr.Empty() || excluded[addr.String()] {
+			return ErrInvalidExcludedNodes(DefaultCodespace)
+		}
+		excluded[addr.String()] = true
+	}
+	return nil
 }
 
 // NewApplication - initialize a new instance of an application
@@ -89,3 +132,26 @@ func (a Application) GetPublicKey() crypto.PublicKey { return a.PublicKey }
 func (a Application) GetTokens() sdk.Int             { return a.StakedTokens }
 func (a Application) GetConsensusPower() int64       { return a.ConsensusPower() }
 func (a Application) GetMaxRelays() sdk.Int          { return a.MaxRelays }
+
+// GetChainMaxRelays returns the maximum relays of a chain, the maximum relays are split between the chains
+// by their weights or evenly without weights (rounded up)
+func (a Application) GetChainMaxRelays(chain string) sdk.Int {
+	if len(a.Chains) == 0 {
+		return sdk.ZeroInt()
+	}
+	weight, totalWeight := sdk.OneInt(), sdk.NewInt(int64(len(a.Chains)))
+	if len(a.ChainWeights) != 0 {
+		weight, totalWeight = sdk.ZeroInt(), sdk.ZeroInt()
+		for _, cw := range a.ChainWeights {
+			if cw.Chain == chain {
+				weight = sdk.NewInt(cw.Weight)
+			}
+			totalWeight = totalWeight.Add(sdk.NewInt(cw.Weight))
+		}
+	}
+	// ceil(maxRelays * weight / totalWeight)
+	return a.MaxRelays.Mul(weight).Add(totalWeight).Sub(sdk.OneInt()).Quo(totalWeight)
+}
+
+// GetExcludedNodes returns the nodes the application excludes from its sessions
+func (a Application) GetExcludedNodes() []sdk.Address { return a.ExcludedNodes }
@@ -29,14 +29,16 @@ func (a Application) String() string {
 
 // this is a helper struct used for JSON de- and encoding only
 type hexApplication struct {
-	Address                 sdk.Address     `json:"address" yaml:"address"`               // the hex address of the application
-	PublicKey               string          `json:"public_key" yaml:"public_key"`         // the hex consensus public key of the application
-	Jailed                  bool            `json:"jailed" yaml:"jailed"`                 // has the application been jailed from staked status?
-	Chains                  []string        `json:"chains" yaml:"chains"`                 // non native (external) blockchains needed for the application
-	MaxRelays               sdk.Int         `json:"max_relays" yaml:"max_relays"`         // maximum number of relays allowed for the application
-	Status                  sdk.StakeStatus `json:"status" yaml:"status"`                 // application status (staked/unstaking/unstaked)
-	StakedTokens            sdk.Int         `json:"staked_tokens" yaml:"staked_tokens"`   // how many staked tokens
-	UnstakingCompletionTime time.Time       `json:"unstaking_time" yaml:"unstaking_time"` // if unstaking, min time for the application to complete unstaking
+	Address                 sdk.Address     `json:"address" yaml:"address"`                         // the hex address of the application
+	PublicKey               string          `json:"public_key" yaml:"public_key"`                   // the hex consensus public key of the application
+	Jailed                  bool            `json:"jailed" yaml:"jailed"`                           // has the application been jailed from staked status?
+	Chains                  []string        `json:"chains" yaml:"chains"`                           // non native (external) blockchains needed for the application
+	MaxRelays               sdk.Int         `json:"max_relays" yaml:"max_relays"`                   // maximum number of relays allowed for the application
+	Status                  sdk.StakeStatus `json:"status" yaml:"status"`                           // application status (staked/unstaking/unstaked)
+	StakedTokens            sdk.Int         `json:"staked_tokens" yaml:"staked_tokens"`             // how many staked tokens
+	UnstakingCompletionTime time.Time       `json:"unstaking_time" yaml:"unstaking_time"`           // if unstaking, min time for the application to complete unstaking
+	ChainWeights            []ChainWeight   `json:"chain_weights,omitempty" yaml:"chain_weights"`   // optional share of the max relays allocated to each chain
+	ExcludedNodes           []sdk.Address   `json:"excluded_nodes,omitempty" yaml:"excluded_nodes"` // nodes only selected for the sessions when no other node is left
 }
 
 // marshal structure into JSON encoding
@@ -55,6 +57,8 @@ func (a Application) MarshalJSON() ([]byte, error) {
 		MaxRelays:               a.MaxRelays,
 		StakedTokens:            a.StakedTokens,
 		UnstakingCompletionTime: a.UnstakingCompletionTime,
+		ChainWeights:            a.ChainWeights,
+		ExcludedNodes:           a.ExcludedNodes,
 	})
 }
 
@@ -77,6 +81,8 @@ func (a *Application) UnmarshalJSON(data []byte) error {
 		StakedTokens:            bv.StakedTokens,
 		Status:                  bv.Status,
 		UnstakingCompletionTime: bv.UnstakingCompletionTime,
+		ChainWeights:            bv.ChainWeights,
+		ExcludedNodes:           bv.ExcludedNodes,
 	}
 	return nil
 }
@@ -1053,3 +1053,52 @@ func TestApplication_GetMaxRelays(t *testing.T) {
 		})
 	}
 }
+
+func TestApplication_GetChainMaxRelays(t *testing.T) {
+	tests := []struct {
+		name         string
+		chainWeights []ChainWeight
+		chain        string
+		want         sdk.Int
+	}{
+		{"split evenly without weights (rounded up)", nil, "0001", sdk.NewInt(334)},
+		{"split by weight", []ChainWeight{{"0001", 95}, {"0002", 4}, {"0003", 1}}, "0001", sdk.NewInt(950)},
+		{"split by weight (rounded up)", []ChainWeight{{"0001", 95}, {"0002", 4}, {"0003", 1}}, "0003", sdk.NewInt(10)},
+		{"not a staked chain", []ChainWeight{{"0001", 95}, {"0002", 4}, {"0003", 1}}, "0004", sdk.ZeroInt()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := Application{
+				Chains:       []string{"0001", "0002", "0003"},
+				ChainWeights: tt.chainWeights,
+				MaxRelays:    sdk.NewInt(1000),
+			}
+			if got := app.GetChainMaxRelays(tt.chain); !got.Equal(tt.want) {
+				t.Errorf("GetChainMaxRelays() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateChainWeights(t *testing.T) {
+	chains := []string{"0001", "0002"}
+	tests := []struct {
+		name         string
+		chainWeights []ChainWeight
+		wantErr      bool
+	}{
+		{"no weights", nil, false},
+		{"a weight for each chain", []ChainWeight{{"0001", 9}, {"0002", 1}}, false},
+		{"missing a chain", []ChainWeight{{"0001", 9}}, true},
+		{"not a staked chain", []ChainWeight{{"0001", 9}, {"0003", 1}}, true},
+		{"duplicate chain", []ChainWeight{{"0001", 9}, {"0001", 1}}, true},
+		{"weight not positive", []ChainWeight{{"0001", 9}, {"0002", 0}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateChainWeights(chains, tt.chainWeights); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateChainWeights() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/pokt-network/pocket-core/x/apps/exported"
 	"github.com/pokt-network/posmint/codec"
 )
 
@@ -9,6 +10,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgAppStake{}, "apps/MsgAppStake", nil)
 	cdc.RegisterConcrete(MsgBeginAppUnstake{}, "apps/MsgAppBeginUnstake", nil)
 	cdc.RegisterConcrete(MsgAppUnjail{}, "apps/MsgAppUnjail", nil)
+	cdc.RegisterConcrete(MsgAppPartialUnstake{}, "apps/MsgAppPartialUnstake", nil)
+	cdc.RegisterConcrete(MsgAppExcludeNodes{}, "apps/MsgAppExcludeNodes", nil)
+	cdc.RegisterInterface((*exported.ApplicationI)(nil), nil)
+	cdc.RegisterConcrete(Application{}, "apps/Application", nil)
 }
 
 var ModuleCdc *codec.Codec // generic sealed codec to be used throughout this module
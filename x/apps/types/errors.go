@@ -21,6 +21,11 @@ const (
 	CodeNotEnoughCoins        CodeType          = 112
 	CodeInvalidStakeAmount    CodeType          = 115
 	CodeNoChains              CodeType          = 116
+	CodeUnstakeBelowMinimum   CodeType          = 117
+	CodeStakeDecrease         CodeType          = 118
+	CodeInvalidChainWeights   CodeType          = 119
+	CodeTooManyExcludedNodes  CodeType          = 120
+	CodeInvalidExcludedNodes  CodeType          = 121
 )
 
 func ErrNoChains(codespace sdk.CodespaceType) sdk.Error {
@@ -80,3 +85,23 @@ func ErrMissingAppStake(codespace sdk.CodespaceType) sdk.Error {
 func ErrStakeTooLow(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeApplicationNotJailed, "application's self delegation less than min stake, cannot be unjailed")
 }
+
+func ErrUnstakeBelowMinimum(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnstakeBelowMinimum, "the partial unstake would leave the application below the minimum stake, must begin unstaking")
+}
+
+func ErrStakeDecrease(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeStakeDecrease, "a staked application may not decrease its stake when editing, must partially unstake")
+}
+
+func ErrInvalidChainWeights(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidChainWeights, "the chain weights must be positive and have exactly one weight for each staked chain")
+}
+
+func ErrTooManyExcludedNodes(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeTooManyExcludedNodes, "the application excludes more nodes than the max excluded nodes allowed")
+}
+
+func ErrInvalidExcludedNodes(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidExcludedNodes, "the excluded nodes must be distinct non empty addresses")
+}
@@ -353,3 +353,27 @@ func TestError_ErrApplicationPubKeyTypeNotSupported(t *testing.T) {
 		})
 	}
 }
+func TestError_ErrUnstakeBelowMinimum(t *testing.T) {
+	type args struct {
+		codespace sdk.CodespaceType
+	}
+	tests := []struct {
+		name string
+		args
+		want sdk.Error
+	}{
+		{
+			name: "returns error partial unstake below the minimum stake",
+			args: args{codespace},
+			want: sdk.NewError(codespace, sdk.CodeType(117), "the partial unstake would leave the application below the minimum stake, must begin unstaking"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrUnstakeBelowMinimum(tt.args.codespace); got.Error() != tt.want.Error() {
+				t.Errorf("ErrUnstakeBelowMinimum(): returns %v but want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,11 +2,19 @@ package types
 
 // pos module event types
 const (
-	EventTypeCompleteUnstaking = "complete_unstaking"
-	EventTypeCreateApplication = "create_application"
-	EventTypeStake             = "stake"
-	EventTypeBeginUnstake      = "begin_unstake"
-	EventTypeUnstake           = "unstake"
-	AttributeKeyApplication    = "application"
-	AttributeValueCategory     = ModuleName
+	EventTypeCompleteUnstaking      = "complete_unstaking"
+	EventTypeCreateApplication      = "create_application"
+	EventTypeStake                  = "stake"
+	EventTypeBeginUnstake           = "begin_unstake"
+	EventTypeUnstake                = "unstake"
+	EventTypePartialUnstake         = "partial_unstake"
+	EventTypeCompletePartialUnstake = "complete_partial_unstake"
+	EventTypeEditStake              = "edit_stake"
+	EventTypeCompleteEditStake      = "complete_edit_stake"
+	EventTypeUpdateMaxRelays        = "update_max_relays"
+	EventTypeExcludeNodes           = "exclude_nodes"
+	AttributeKeyApplication         = "application"
+	AttributeKeyMaxRelays           = "max_relays"
+	AttributeKeyCompletionTime      = "completion_time"
+	AttributeValueCategory          = ModuleName
 )
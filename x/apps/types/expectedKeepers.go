@@ -10,6 +10,8 @@ type PosKeeper interface {
 	StakeDenom(ctx sdk.Ctx) (res string)
 	// GetStakedTokens total staking tokens supply which is staked
 	GetStakedTokens(ctx sdk.Ctx) sdk.Int
+	// SessionBlockFrequency the number of blocks in a session
+	SessionBlockFrequency(ctx sdk.Ctx) (res int64)
 }
 
 // AuthKeeper defines the expected supply Keeper (noalias)
@@ -6,9 +6,11 @@ import (
 
 // GenesisState - all staking state that must be provided at genesis
 type GenesisState struct {
-	Params       Params       `json:"params" yaml:"params"`
-	Applications Applications `json:"applications" yaml:"applications"`
-	Exported     bool         `json:"exported" yaml:"exported"`
+	Params          Params           `json:"params" yaml:"params"`
+	Applications    Applications     `json:"applications" yaml:"applications"`
+	Exported        bool             `json:"exported" yaml:"exported"`
+	PartialUnstakes []PartialUnstake `json:"partial_unstakes" yaml:"partial_unstakes"`
+	AppEdits        []AppEdit        `json:"app_edits" yaml:"app_edits"`
 }
 
 // PrevState application power, needed for application set update logic
@@ -20,7 +22,9 @@ type PrevStatePowerMapping struct {
 // get raw genesis raw message for testing
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params:       DefaultParams(),
-		Applications: make(Applications, 0),
+		Params:          DefaultParams(),
+		Applications:    make(Applications, 0),
+		PartialUnstakes: make([]PartialUnstake, 0),
+		AppEdits:        make([]AppEdit, 0),
 	}
 }
@@ -10,8 +10,10 @@ func TestDefaultGenesisState(t *testing.T) {
 		name string
 		want GenesisState
 	}{{"defaultState", GenesisState{
-		Params:       DefaultParams(),
-		Applications: make(Applications, 0),
+		Params:          DefaultParams(),
+		Applications:    make(Applications, 0),
+		PartialUnstakes: make([]PartialUnstake, 0),
+		AppEdits:        make([]AppEdit, 0),
 	}},
 	}
 	for _, tt := range tests {
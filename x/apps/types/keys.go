@@ -15,10 +15,14 @@ const (
 )
 
 var (
-	AllApplicationsKey = []byte{0x01} // prefix for each key to a application
-	StakedAppsKey      = []byte{0x02} // prefix for each key to a staked application index, sorted by power
-	UnstakingAppsKey   = []byte{0x03} // prefix for unstaking application
-	BurnApplicationKey = []byte{0x04} // prefix for awarding applications
+	AllApplicationsKey  = []byte{0x01} // prefix for each key to a application
+	StakedAppsKey       = []byte{0x02} // prefix for each key to a staked application index, sorted by power
+	UnstakingAppsKey    = []byte{0x03} // prefix for unstaking application
+	BurnApplicationKey  = []byte{0x04} // prefix for awarding applications
+	PartialUnstakingKey = []byte{0x05} // prefix for each key to a partial unstake, sorted by completion time
+	PendingAppEditKey   = []byte{0x06} // prefix for each key to an edit of a staked application, applied at the next session
+	UsageKey            = []byte{0x07} // prefix for each key to a relay usage, grouped by application and sorted by session height
+	UsageBySessionKey   = []byte{0x08} // prefix for each key to a relay usage index, sorted by session height (used for pruning)
 )
 
 // Removes the prefix bytes from a key to expose true address
@@ -37,6 +41,49 @@ func KeyForUnstakingApps(unstakingTime time.Time) []byte {
 	return append(UnstakingAppsKey, bz...) // use the unstaking time as part of the key
 }
 
+// generates the key prefix for the partial unstakes completing at the completion time
+func KeyForPartialUnstakes(completionTime time.Time) []byte {
+	bz := sdk.FormatTimeBytes(completionTime)
+	return append(append([]byte{}, PartialUnstakingKey...), bz...)
+}
+
+// generates the key for the partial unstake of an application
+func KeyForPartialUnstake(completionTime time.Time, addr sdk.Address) []byte {
+	return append(KeyForPartialUnstakes(completionTime), addr.Bytes()...)
+}
+
+// generates the key for the pending edit of an application
+func KeyForAppEdit(addr sdk.Address) []byte {
+	return append(append([]byte{}, PendingAppEditKey...), addr.Bytes()...)
+}
+
+// generates the key prefix for the relay usage of an application
+func KeyForUsages(addr sdk.Address) []byte {
+	return append(append([]byte{}, UsageKey...), addr.Bytes()...)
+}
+
+// generates the key for the relay usage of an application for a chain in a session
+func KeyForUsage(addr sdk.Address, sessionHeight int64, chain string) []byte {
+	return append(append(KeyForUsages(addr), heightBytes(sessionHeight)...), []byte(chain)...)
+}
+
+// generates the key prefix for the relay usage index of a session
+func KeyForUsagesBySession(sessionHeight int64) []byte {
+	return append(append([]byte{}, UsageBySessionKey...), heightBytes(sessionHeight)...)
+}
+
+// generates the key for the index of the relay usage of an application for a chain in a session
+func KeyForUsageBySession(sessionHeight int64, addr sdk.Address, chain string) []byte {
+	return append(append(KeyForUsagesBySession(sessionHeight), addr.Bytes()...), []byte(chain)...)
+}
+
+// returns the big endian bytes of a height so the keys are sorted by height
+func heightBytes(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return bz
+}
+
 // generates the key for a application in the staking set
 func KeyForAppInStakingSet(app Application) []byte {
 	// NOTE the address doesn't need to be stored because counter bytes must always be different
@@ -120,3 +120,46 @@ func TestKeyForApplicationInStakingSet(t *testing.T) {
 		})
 	}
 }
+
+func TestKeyForPartialUnstake(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	addr := types.Address(pub.Address())
+	ut := time.Now()
+
+	prefix := append([]byte{0x05}, types.FormatTimeBytes(ut)...)
+	if got := KeyForPartialUnstakes(ut); !reflect.DeepEqual(got, prefix) {
+		t.Errorf("KeyForPartialUnstakes() = %v, want %v", got, prefix)
+	}
+	want := append(append([]byte{}, prefix...), addr.Bytes()...)
+	if got := KeyForPartialUnstake(ut, addr); !reflect.DeepEqual(got, want) {
+		t.Errorf("KeyForPartialUnstake() = %v, want %v", got, want)
+	}
+}
+
+func TestKeyForAppEdit(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	addr := types.Address(pub.Address())
+
+	want := append([]byte{0x06}, addr.Bytes()...)
+	if got := KeyForAppEdit(addr); !reflect.DeepEqual(got, want) {
+		t.Errorf("KeyForAppEdit() = %v, want %v", got, want)
+	}
+}
+
+func TestKeyForUsage(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	addr := types.Address(pub.Address())
+	height := []byte{0, 0, 0, 0, 0, 0, 0, 5}
+
+	want := append(append(append([]byte{0x07}, addr.Bytes()...), height...), []byte("0001")...)
+	if got := KeyForUsage(addr, 5, "0001"); !reflect.DeepEqual(got, want) {
+		t.Errorf("KeyForUsage() = %v, want %v", got, want)
+	}
+	want = append(append(append([]byte{0x08}, height...), addr.Bytes()...), []byte("0001")...)
+	if got := KeyForUsageBySession(5, addr, "0001"); !reflect.DeepEqual(got, want) {
+		t.Errorf("KeyForUsageBySession() = %v, want %v", got, want)
+	}
+}
@@ -10,12 +10,16 @@ var (
 	_ sdk.Msg = &MsgAppStake{}
 	_ sdk.Msg = &MsgBeginAppUnstake{}
 	_ sdk.Msg = &MsgAppUnjail{}
+	_ sdk.Msg = &MsgAppPartialUnstake{}
+	_ sdk.Msg = &MsgAppExcludeNodes{}
 )
 
 const (
-	MsgAppStakeName   = "app_stake"
-	MsgAppUnstakeName = "app_begin_unstake"
-	MsgAppUnjailName  = "app_unjail"
+	MsgAppStakeName          = "app_stake"
+	MsgAppUnstakeName        = "app_begin_unstake"
+	MsgAppUnjailName         = "app_unjail"
+	MsgAppPartialUnstakeName = "app_partial_unstake"
+	MsgAppExcludeNodesName   = "app_exclude_nodes"
 )
 
 //----------------------------------------------------------------------------------------------------------------------
@@ -24,6 +28,8 @@ type MsgAppStake struct {
 	PubKey crypto.PublicKey `json:"pubkey" yaml:"pubkey"`
 	Chains []string         `json:"chains" yaml:"chains"`
 	Value  sdk.Int          `json:"value" yaml:"value"`
+	// optional share of the max relays allocated to each chain, omitted when empty to keep the sign bytes
+	ChainWeights []ChainWeight `json:"chain_weights,omitempty" yaml:"chain_weights"`
 }
 
 // Return address(es) that must sign over msg.GetSignBytes()
@@ -54,7 +60,7 @@ func (msg MsgAppStake) ValidateBasic() sdk.Error {
 			return ErrNoChains(DefaultCodespace)
 		}
 	}
-	return nil
+	return ValidateChainWeights(msg.Chains, msg.ChainWeights)
 }
 
 func (msg MsgAppStake) Route() string { return RouterKey }
@@ -88,6 +94,67 @@ func (msg MsgBeginAppUnstake) ValidateBasic() sdk.Error {
 func (msg MsgBeginAppUnstake) Route() string { return RouterKey }
 func (msg MsgBeginAppUnstake) Type() string  { return MsgAppUnstakeName }
 
+//----------------------------------------------------------------------------------------------------------------------
+// MsgAppPartialUnstake - struct for unstaking part of the stake while staying staked
+type MsgAppPartialUnstake struct {
+	Address sdk.Address `json:"application_address" yaml:"application_address"`
+	Amount  sdk.Int     `json:"amount" yaml:"amount"`
+}
+
+// Return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgAppPartialUnstake) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Address}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgAppPartialUnstake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Quick validity check for partially unstaking an application
+func (msg MsgAppPartialUnstake) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return ErrNilApplicationAddr(DefaultCodespace)
+	}
+	if msg.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadStakeAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+func (msg MsgAppPartialUnstake) Route() string { return RouterKey }
+func (msg MsgAppPartialUnstake) Type() string  { return MsgAppPartialUnstakeName }
+
+//----------------------------------------------------------------------------------------------------------------------
+// MsgAppExcludeNodes - struct for replacing the nodes an application excludes from its sessions
+type MsgAppExcludeNodes struct {
+	Address       sdk.Address   `json:"application_address" yaml:"application_address"`
+	ExcludedNodes []sdk.Address `json:"excluded_nodes" yaml:"excluded_nodes"` // an empty list removes the exclusions
+}
+
+// Return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgAppExcludeNodes) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Address}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgAppExcludeNodes) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Quick validity check for excluding nodes, the max excluded nodes is checked against the params
+func (msg MsgAppExcludeNodes) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return ErrNilApplicationAddr(DefaultCodespace)
+	}
+	return ValidateExcludedNodes(msg.ExcludedNodes)
+}
+
+func (msg MsgAppExcludeNodes) Route() string { return RouterKey }
+func (msg MsgAppExcludeNodes) Type() string  { return MsgAppExcludeNodesName }
+
 //----------------------------------------------------------------------------------------------------------------------
 // MsgAppUnjail - struct for unjailing jailed application
 type MsgAppUnjail struct {
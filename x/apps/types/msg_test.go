@@ -154,6 +154,11 @@ func TestMsgApp_ValidateBasic(t *testing.T) {
 			args: args{MsgAppStake{PubKey: msgAppStake.PubKey, Value: msgAppStake.Value, Chains: []string{"a"}}},
 			want: types.NewInvalidHashLengthError("pocketcore"),
 		},
+		{
+			name: "errs if the chain weights do not match the chains",
+			args: args{MsgAppStake{PubKey: msgAppStake.PubKey, Value: msgAppStake.Value, Chains: msgAppStake.Chains, ChainWeights: []ChainWeight{{Chain: "0001", Weight: 1}}}},
+			want: ErrInvalidChainWeights(DefaultCodespace),
+		},
 		{
 			name: "returns nil if valid address",
 			args: args{msgAppStake},
@@ -414,3 +419,60 @@ func TestMsgAppUnjail_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+func TestMsgAppPartialUnstake_ValidateBasic(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	addr := sdk.Address(pub.Address())
+	tests := []struct {
+		name string
+		msg  MsgAppPartialUnstake
+		want sdk.Error
+	}{
+		{"returns nil if valid", MsgAppPartialUnstake{addr, sdk.NewInt(1)}, nil},
+		{"errs if no Address", MsgAppPartialUnstake{nil, sdk.NewInt(1)}, ErrNilApplicationAddr(DefaultCodespace)},
+		{"errs if amount is zero", MsgAppPartialUnstake{addr, sdk.ZeroInt()}, ErrBadStakeAmount(DefaultCodespace)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+			if got := tt.msg.GetSigners(); !reflect.DeepEqual(got, []sdk.Address{tt.msg.Address}) {
+				t.Errorf("GetSigners() = %v, want %v", got, []sdk.Address{tt.msg.Address})
+			}
+		})
+	}
+}
+
+func TestMsgAppExcludeNodes_ValidateBasic(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	addr := sdk.Address(pub.Address())
+	var nodePub crypto.Ed25519PublicKey
+	rand.Read(nodePub[:])
+	node := sdk.Address(nodePub.Address())
+	tests := []struct {
+		name string
+		msg  MsgAppExcludeNodes
+		want sdk.Error
+	}{
+		{"returns nil if valid", MsgAppExcludeNodes{addr, []sdk.Address{node}}, nil},
+		{"returns nil if removing the exclusions", MsgAppExcludeNodes{addr, nil}, nil},
+		{"errs if no Address", MsgAppExcludeNodes{nil, []sdk.Address{node}}, ErrNilApplicationAddr(DefaultCodespace)},
+		{"errs if duplicate node", MsgAppExcludeNodes{addr, []sdk.Address{node, node}}, ErrInvalidExcludedNodes(DefaultCodespace)},
+		{"errs if empty node", MsgAppExcludeNodes{addr, []sdk.Address{{}}}, ErrInvalidExcludedNodes(DefaultCodespace)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+			if got := tt.msg.GetSigners(); !reflect.DeepEqual(got, []sdk.Address{tt.msg.Address}) {
+				t.Errorf("GetSigners() = %v, want %v", got, []sdk.Address{tt.msg.Address})
+			}
+			if got := tt.msg.Type(); got != MsgAppExcludeNodesName {
+				t.Errorf("Type() = %v, want %v", got, MsgAppExcludeNodesName)
+			}
+		})
+	}
+}
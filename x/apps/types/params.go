@@ -20,28 +20,39 @@ const (
 	DefaultBaseRelaysPerPOKT   int64  = 100
 	DefaultStabilityAdjustment int64  = 0
 	DefaultParticipationRateOn bool   = false
+	DefaultUsageRetention      int64  = 1000
+	DefaultMaxExcludedNodes    int64  = 5
 )
 
 // Keys for parameter access
 var (
-	KeyUnstakingTime       = []byte("AppUnstakingTime")
-	KeyMaxApplications     = []byte("MaxApplications")
-	KeyApplicationMinStake = []byte("ApplicationStakeMinimum")
-	BaseRelaysPerPOKT      = []byte("BaseRelaysPerPOKT")
-	StabilityAdjustment    = []byte("StabilityAdjustment")
-	ParticipationRateOn    = []byte("ParticipationRateOn")
+	KeyUnstakingTime             = []byte("AppUnstakingTime")
+	KeyMaxApplications           = []byte("MaxApplications")
+	KeyApplicationMinStake       = []byte("ApplicationStakeMinimum")
+	BaseRelaysPerPOKT            = []byte("BaseRelaysPerPOKT")
+	StabilityAdjustment          = []byte("StabilityAdjustment")
+	ParticipationRateOn          = []byte("ParticipationRateOn")
+	KeyUsageRetention            = []byte("UsageRetention")
+	KeySlashFractionMisbehaviour = []byte("SlashFractionMisbehaviour")
+	KeyMaxExcludedNodes          = []byte("MaxExcludedNodes")
 )
 
+// the default fraction of the stake burned for an application misbehaviour (1%)
+var DefaultSlashFractionMisbehaviour = types.NewDec(1).Quo(types.NewDec(100))
+
 var _ types.ParamSet = (*Params)(nil)
 
 // Params defines the high level settings for pos module
 type Params struct {
-	UnstakingTime       time.Duration `json:"unstaking_time" yaml:"unstaking_time"`               // duration of unstaking
-	MaxApplications     uint64        `json:"max_applications" yaml:"max_applications"`           // maximum number of applications
-	AppStakeMin         int64         `json:"app_stake_minimum" yaml:"app_stake_minimum"`         // minimum amount needed to stake as an application
-	BaseRelaysPerPOKT   int64         `json:"base_relays_per_pokt" yaml:"base_relays_per_pokt"`   // base relays per POKT coin staked
-	StabilityAdjustment int64         `json:"stability_adjustment" yaml:"stability_adjustment"`   // the stability adjustment from the governance
-	ParticipationRateOn bool          `json:"participation_rate_on" yaml:"participation_rate_on"` // the participation rate affects the amount minted based on staked ratio
+	UnstakingTime             time.Duration `json:"unstaking_time" yaml:"unstaking_time"`                           // duration of unstaking
+	MaxApplications           uint64        `json:"max_applications" yaml:"max_applications"`                       // maximum number of applications
+	AppStakeMin               int64         `json:"app_stake_minimum" yaml:"app_stake_minimum"`                     // minimum amount needed to stake as an application
+	BaseRelaysPerPOKT         int64         `json:"base_relays_per_pokt" yaml:"base_relays_per_pokt"`               // base relays per POKT coin staked
+	StabilityAdjustment       int64         `json:"stability_adjustment" yaml:"stability_adjustment"`               // the stability adjustment from the governance
+	ParticipationRateOn       bool          `json:"participation_rate_on" yaml:"participation_rate_on"`             // the participation rate affects the amount minted based on staked ratio
+	UsageRetention            int64         `json:"usage_retention" yaml:"usage_retention"`                         // how many blocks the relay usage of the applications is kept
+	SlashFractionMisbehaviour types.Dec     `json:"slash_fraction_misbehaviour" yaml:"slash_fraction_misbehaviour"` // the fraction of the stake burned when an application misbehaves
+	MaxExcludedNodes          int64         `json:"max_excluded_nodes" yaml:"max_excluded_nodes"`                   // the maximum number of nodes an application may exclude from its sessions
 }
 
 // Implements params.ParamSet
@@ -53,18 +64,24 @@ func (p *Params) ParamSetPairs() types.ParamSetPairs {
 		{Key: BaseRelaysPerPOKT, Value: &p.BaseRelaysPerPOKT},
 		{Key: StabilityAdjustment, Value: &p.StabilityAdjustment},
 		{Key: ParticipationRateOn, Value: &p.ParticipationRateOn},
+		{Key: KeyUsageRetention, Value: &p.UsageRetention},
+		{Key: KeySlashFractionMisbehaviour, Value: &p.SlashFractionMisbehaviour},
+		{Key: KeyMaxExcludedNodes, Value: &p.MaxExcludedNodes},
 	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
 	return Params{
-		UnstakingTime:       DefaultUnstakingTime,
-		MaxApplications:     DefaultMaxApplications,
-		AppStakeMin:         DefaultMinStake,
-		BaseRelaysPerPOKT:   DefaultBaseRelaysPerPOKT,
-		StabilityAdjustment: DefaultStabilityAdjustment,
-		ParticipationRateOn: DefaultParticipationRateOn,
+		UnstakingTime:             DefaultUnstakingTime,
+		MaxApplications:           DefaultMaxApplications,
+		AppStakeMin:               DefaultMinStake,
+		BaseRelaysPerPOKT:         DefaultBaseRelaysPerPOKT,
+		StabilityAdjustment:       DefaultStabilityAdjustment,
+		ParticipationRateOn:       DefaultParticipationRateOn,
+		UsageRetention:            DefaultUsageRetention,
+		SlashFractionMisbehaviour: DefaultSlashFractionMisbehaviour,
+		MaxExcludedNodes:          DefaultMaxExcludedNodes,
 	}
 }
 
@@ -79,6 +96,15 @@ func (p Params) Validate() error {
 	if p.BaseRelaysPerPOKT < 0 {
 		return fmt.Errorf("invalid baseline throughput stake rate, must be above 0")
 	}
+	if p.UsageRetention < 0 {
+		return fmt.Errorf("the usage retention must not be negative")
+	}
+	if p.SlashFractionMisbehaviour.IsNil() || p.SlashFractionMisbehaviour.IsNegative() || p.SlashFractionMisbehaviour.GT(types.OneDec()) {
+		return fmt.Errorf("the misbehaviour slash fraction must be between 0 and 1")
+	}
+	if p.MaxExcludedNodes < 0 {
+		return fmt.Errorf("the max excluded nodes must not be negative")
+	}
 	// todo
 	return nil
 }
@@ -98,13 +124,19 @@ func (p Params) String() string {
   Minimum Stake:     	       %d
   BaseRelaysPerPOKT            %d
   Stability Adjustment         %d
-  Participation Rate On        %v,`,
+  Participation Rate On        %v
+  Usage Retention              %d
+  Slash Fraction Misbehaviour  %s
+  Max Excluded Nodes           %d,`,
 		p.UnstakingTime,
 		p.MaxApplications,
 		p.AppStakeMin,
 		p.BaseRelaysPerPOKT,
 		p.StabilityAdjustment,
-		p.ParticipationRateOn)
+		p.ParticipationRateOn,
+		p.UsageRetention,
+		p.SlashFractionMisbehaviour,
+		p.MaxExcludedNodes)
 }
 
 // unmarshal the current pos params value from store key or panic
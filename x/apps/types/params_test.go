@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	sdk "github.com/pokt-network/posmint/types"
 	"reflect"
 	"testing"
 	"time"
@@ -14,12 +15,15 @@ func TestDefaultParams(t *testing.T) {
 	}{
 		{"Default Test",
 			Params{
-				UnstakingTime:       DefaultUnstakingTime,
-				MaxApplications:     DefaultMaxApplications,
-				AppStakeMin:         DefaultMinStake,
-				BaseRelaysPerPOKT:   DefaultBaseRelaysPerPOKT,
-				StabilityAdjustment: DefaultStabilityAdjustment,
-				ParticipationRateOn: DefaultParticipationRateOn,
+				UnstakingTime:             DefaultUnstakingTime,
+				MaxApplications:           DefaultMaxApplications,
+				AppStakeMin:               DefaultMinStake,
+				BaseRelaysPerPOKT:         DefaultBaseRelaysPerPOKT,
+				StabilityAdjustment:       DefaultStabilityAdjustment,
+				ParticipationRateOn:       DefaultParticipationRateOn,
+				UsageRetention:            DefaultUsageRetention,
+				SlashFractionMisbehaviour: DefaultSlashFractionMisbehaviour,
+				MaxExcludedNodes:          DefaultMaxExcludedNodes,
 			},
 		}}
 	for _, tt := range tests {
@@ -105,6 +109,9 @@ func TestParams_Validate(t *testing.T) {
 		BaselineThrouhgputStakeRate int64         `json:"baseline_throughput_stake_rate" yaml:"baseline_throughput_stake_rate"`
 		StabilityAdjustment         int64         `json:"staking_adjustment" yaml:"staking_adjustment"`
 		ParticipationRateOn         bool          `json:"participation_rate_on" yaml:"participation_rate_on"`
+		UsageRetention              int64         `json:"usage_retention" yaml:"usage_retention"`
+		SlashFractionMisbehaviour   sdk.Dec       `json:"slash_fraction_misbehaviour" yaml:"slash_fraction_misbehaviour"`
+		MaxExcludedNodes            int64         `json:"max_excluded_nodes" yaml:"max_excluded_nodes"`
 	}
 	tests := []struct {
 		name    string
@@ -131,6 +138,29 @@ func TestParams_Validate(t *testing.T) {
 			AppStakeMin:                 1000000,
 			BaselineThrouhgputStakeRate: -1,
 		}, true},
+		{"Default Validation Test / Wrong UsageRetention", fields{
+			UnstakingTime:               10000,
+			MaxApplications:             2,
+			AppStakeMin:                 1000000,
+			BaselineThrouhgputStakeRate: 90,
+			UsageRetention:              -1,
+			SlashFractionMisbehaviour:   DefaultSlashFractionMisbehaviour,
+		}, true},
+		{"Default Validation Test / Wrong SlashFractionMisbehaviour", fields{
+			UnstakingTime:               10000,
+			MaxApplications:             2,
+			AppStakeMin:                 1000000,
+			BaselineThrouhgputStakeRate: 90,
+			SlashFractionMisbehaviour:   sdk.NewDec(2),
+		}, true},
+		{"Default Validation Test / Wrong MaxExcludedNodes", fields{
+			UnstakingTime:               10000,
+			MaxApplications:             2,
+			AppStakeMin:                 1000000,
+			BaselineThrouhgputStakeRate: 90,
+			SlashFractionMisbehaviour:   DefaultSlashFractionMisbehaviour,
+			MaxExcludedNodes:            -1,
+		}, true},
 		{"Default Validation Test / Valid", fields{
 			UnstakingTime:               10000,
 			MaxApplications:             2,
@@ -138,17 +168,21 @@ func TestParams_Validate(t *testing.T) {
 			BaselineThrouhgputStakeRate: 90,
 			StabilityAdjustment:         100,
 			ParticipationRateOn:         false,
+			SlashFractionMisbehaviour:   DefaultSlashFractionMisbehaviour,
 		}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := Params{
-				UnstakingTime:       tt.fields.UnstakingTime,
-				MaxApplications:     tt.fields.MaxApplications,
-				AppStakeMin:         tt.fields.AppStakeMin,
-				BaseRelaysPerPOKT:   tt.fields.BaselineThrouhgputStakeRate,
-				StabilityAdjustment: tt.fields.StabilityAdjustment,
-				ParticipationRateOn: tt.fields.ParticipationRateOn,
+				UnstakingTime:             tt.fields.UnstakingTime,
+				MaxApplications:           tt.fields.MaxApplications,
+				AppStakeMin:               tt.fields.AppStakeMin,
+				BaseRelaysPerPOKT:         tt.fields.BaselineThrouhgputStakeRate,
+				StabilityAdjustment:       tt.fields.StabilityAdjustment,
+				ParticipationRateOn:       tt.fields.ParticipationRateOn,
+				UsageRetention:            tt.fields.UsageRetention,
+				SlashFractionMisbehaviour: tt.fields.SlashFractionMisbehaviour,
+				MaxExcludedNodes:          tt.fields.MaxExcludedNodes,
 			}
 			if err := p.Validate(); (err != nil) != tt.wantErr {
 				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
@@ -177,10 +211,13 @@ func TestParams_MustMarshalMarshal(t *testing.T) {
 			"Unmarshal application",
 			false,
 			Params{
-				UnstakingTime:     DefaultUnstakingTime,
-				MaxApplications:   DefaultMaxApplications,
-				AppStakeMin:       DefaultMinStake,
-				BaseRelaysPerPOKT: DefaultBaseRelaysPerPOKT,
+				UnstakingTime:             DefaultUnstakingTime,
+				MaxApplications:           DefaultMaxApplications,
+				AppStakeMin:               DefaultMinStake,
+				BaseRelaysPerPOKT:         DefaultBaseRelaysPerPOKT,
+				UsageRetention:            DefaultUsageRetention,
+				SlashFractionMisbehaviour: DefaultSlashFractionMisbehaviour,
+				MaxExcludedNodes:          DefaultMaxExcludedNodes,
 			},
 			args{moduleCdc.MustMarshalBinaryLengthPrefixed(DefaultParams())},
 		},
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+	"github.com/pokt-network/posmint/codec"
+	sdk "github.com/pokt-network/posmint/types"
+	"time"
+)
+
+// PartialUnstake - part of the stake of a staked application waiting for the unstaking time to be released
+type PartialUnstake struct {
+	Address        sdk.Address `json:"address" yaml:"address"`                 // the application that unstaked the tokens
+	Amount         sdk.Int     `json:"amount" yaml:"amount"`                   // the tokens to be released
+	CompletionTime time.Time   `json:"completion_time" yaml:"completion_time"` // min time for the tokens to be released
+}
+
+// HashString returns a human readable string representation of a partial unstake.
+func (pu PartialUnstake) String() string {
+	return fmt.Sprintf("Address:\t\t%s\nAmount:\t\t\t%s\nCompletion Time:\t\t%v", pu.Address, pu.Amount, pu.CompletionTime)
+}
+
+// MUST return the amino encoded version of this partial unstake
+func MustMarshalPartialUnstake(cdc *codec.Codec, pu PartialUnstake) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(pu)
+}
+
+// MUST decode the partial unstake from the bytes
+func MustUnmarshalPartialUnstake(cdc *codec.Codec, bz []byte) (pu PartialUnstake) {
+	cdc.MustUnmarshalBinaryLengthPrefixed(bz, &pu)
+	return
+}
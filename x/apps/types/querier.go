@@ -1,7 +1,11 @@
 package types
 
 import (
+	"bytes"
+	"fmt"
 	sdk "github.com/pokt-network/posmint/types"
+	"sort"
+	"strings"
 )
 
 // query endpoints supported by the staking Querier
@@ -14,6 +18,8 @@ const (
 	QueryAppStakedPool         = "appStakedPool"
 	QueryAppUnstakedPool       = "appUnstakedPool"
 	QueryParameters            = "parameters"
+	QueryAppUsage              = "app_usage"
+	QueryUnstakingSchedule     = "unstaking_schedule"
 )
 
 type QueryAppParams struct {
@@ -26,12 +32,123 @@ func NewQueryAppParams(applicationAddr sdk.Address) QueryAppParams {
 	}
 }
 
+// the relay usage of an application, an empty chain matches all the chains
+type QueryAppUsageParams struct {
+	Address sdk.Address
+	Chain   string
+}
+
+func NewQueryAppUsageParams(applicationAddr sdk.Address, chain string) QueryAppUsageParams {
+	return QueryAppUsageParams{
+		Address: applicationAddr,
+		Chain:   chain,
+	}
+}
+
+// the filters and sort orders of the applications query
+const (
+	StakingStatusStaked    = "staked"
+	StakingStatusUnstaking = "unstaking"
+	StakingStatusUnstaked  = "unstaked"
+	JailedStatusJailed     = "jailed"
+	JailedStatusUnjailed   = "unjailed"
+	SortByAddress          = "address"
+	SortByTokens           = "tokens"
+)
+
+// the page of the applications matching every filter, an empty filter matches all the applications
 type QueryAppsParams struct {
-	Page, Limit int
+	Page, Limit   int
+	StakingStatus string // staked, unstaking or unstaked
+	JailedStatus  string // jailed or unjailed
+	Blockchain    string // the hash of a staked chain
+	MinimumStake  int64  // the minimum staked tokens
+	SortBy        string // address (default) or tokens (highest first)
 }
 
 func NewQueryApplicationsParams(page, limit int) QueryAppsParams {
-	return QueryAppsParams{page, limit}
+	return QueryAppsParams{Page: page, Limit: limit}
+}
+
+// stateless check of the filters
+func (p QueryAppsParams) Validate() error {
+	switch strings.ToLower(p.StakingStatus) {
+	case "", StakingStatusStaked, StakingStatusUnstaking, StakingStatusUnstaked:
+	default:
+		return fmt.Errorf("invalid staking status %s, can be staked, unstaked, unstaking, or empty", p.StakingStatus)
+	}
+	switch strings.ToLower(p.JailedStatus) {
+	case "", JailedStatusJailed, JailedStatusUnjailed:
+	default:
+		return fmt.Errorf("invalid jailed status %s, can be jailed, unjailed, or empty", p.JailedStatus)
+	}
+	switch strings.ToLower(p.SortBy) {
+	case "", SortByAddress, SortByTokens:
+	default:
+		return fmt.Errorf("invalid sort order %s, can be address, tokens, or empty", p.SortBy)
+	}
+	if p.MinimumStake < 0 {
+		return fmt.Errorf("invalid minimum stake %d, must not be negative", p.MinimumStake)
+	}
+	return nil
+}
+
+// returns true if the application matches every filter
+func (p QueryAppsParams) IsValid(app Application) bool {
+	switch strings.ToLower(p.StakingStatus) {
+	case StakingStatusStaked:
+		if !app.IsStaked() {
+			return false
+		}
+	case StakingStatusUnstaking:
+		if !app.IsUnstaking() {
+			return false
+		}
+	case StakingStatusUnstaked:
+		if !app.IsUnstaked() {
+			return false
+		}
+	}
+	switch strings.ToLower(p.JailedStatus) {
+	case JailedStatusJailed:
+		if !app.IsJailed() {
+			return false
+		}
+	case JailedStatusUnjailed:
+		if app.IsJailed() {
+			return false
+		}
+	}
+	if p.Blockchain != "" {
+		staked := false
+		for _, chain := range app.Chains {
+			if chain == p.Blockchain {
+				staked = true
+				break
+			}
+		}
+		if !staked {
+			return false
+		}
+	}
+	return app.StakedTokens.GTE(sdk.NewInt(p.MinimumStake))
+}
+
+// returns the applications matching every filter in the sort order
+func (p QueryAppsParams) Filter(applications Applications) Applications {
+	filtered := make(Applications, 0)
+	for _, app := range applications {
+		if p.IsValid(app) {
+			filtered = append(filtered, app)
+		}
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if strings.ToLower(p.SortBy) == SortByTokens && !filtered[i].StakedTokens.Equal(filtered[j].StakedTokens) {
+			return filtered[i].StakedTokens.GT(filtered[j].StakedTokens)
+		}
+		return bytes.Compare(filtered[i].Address, filtered[j].Address) < 0
+	})
+	return filtered
 }
 
 type QueryUnstakingApplicationsParams struct {
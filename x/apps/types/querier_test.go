@@ -115,3 +115,61 @@ func TestNewQueryApplicationsParams(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryAppsParams_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		params QueryAppsParams
+		hasErr bool
+	}{
+		{"no filters", QueryAppsParams{Page: 1}, false},
+		{"every filter", QueryAppsParams{Page: 1, StakingStatus: "Staked", JailedStatus: JailedStatusUnjailed, Blockchain: "0001", MinimumStake: 1, SortBy: SortByTokens}, false},
+		{"invalid staking status", QueryAppsParams{StakingStatus: "foo"}, true},
+		{"invalid jailed status", QueryAppsParams{JailedStatus: "foo"}, true},
+		{"invalid sort order", QueryAppsParams{SortBy: "foo"}, true},
+		{"negative minimum stake", QueryAppsParams{MinimumStake: -1}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.params.Validate(); (err != nil) != tt.hasErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.hasErr)
+			}
+		})
+	}
+}
+
+func TestQueryAppsParams_Filter(t *testing.T) {
+	newApplication := func(addr byte, status types.StakeStatus, jailed bool, chain string, tokens int64) Application {
+		return Application{
+			Address:      types.Address([]byte{addr}),
+			Status:       status,
+			Jailed:       jailed,
+			Chains:       []string{chain},
+			StakedTokens: types.NewInt(tokens),
+		}
+	}
+	a := newApplication(1, types.Staked, false, "0001", 10)
+	b := newApplication(2, types.Staked, true, "0002", 30)
+	c := newApplication(3, types.Unstaking, false, "0001", 20)
+	applications := Applications{c, b, a}
+	tests := []struct {
+		name   string
+		params QueryAppsParams
+		want   Applications
+	}{
+		{"no filters sorts by address", QueryAppsParams{}, Applications{a, b, c}},
+		{"sorts by tokens", QueryAppsParams{SortBy: SortByTokens}, Applications{b, c, a}},
+		{"staking status", QueryAppsParams{StakingStatus: StakingStatusStaked}, Applications{a, b}},
+		{"jailed status", QueryAppsParams{JailedStatus: JailedStatusJailed}, Applications{b}},
+		{"blockchain", QueryAppsParams{Blockchain: "0001"}, Applications{a, c}},
+		{"minimum stake", QueryAppsParams{MinimumStake: 20}, Applications{b, c}},
+		{"no match", QueryAppsParams{StakingStatus: StakingStatusUnstaked}, Applications{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.params.Filter(applications); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Filter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+	sdk "github.com/pokt-network/posmint/types"
+	"sort"
+	"time"
+)
+
+// UnstakingEntry - tokens of an application in the unstaking queues and the expected time of their release
+type UnstakingEntry struct {
+	Address        sdk.Address `json:"address" yaml:"address"`                 // the application that unstakes the tokens
+	Amount         sdk.Int     `json:"amount" yaml:"amount"`                   // the tokens returned to the application
+	CompletionTime time.Time   `json:"completion_time" yaml:"completion_time"` // min time for the tokens to be released, unset if waiting
+	Partial        bool        `json:"partial" yaml:"partial"`                 // a partial unstake, the application stays staked
+	Waiting        bool        `json:"waiting" yaml:"waiting"`                 // waiting to begin unstaking at the end of the session
+	BeginHeight    int64       `json:"begin_height" yaml:"begin_height"`       // if waiting, the height the unstaking begins at
+}
+
+// HashString returns a human readable string representation of an unstaking entry.
+func (ue UnstakingEntry) String() string {
+	return fmt.Sprintf("Address:\t\t%s\nAmount:\t\t\t%s\nCompletion Time:\t%v\nPartial:\t\t%v\nWaiting:\t\t%v\nBegin Height:\t\t%d",
+		ue.Address, ue.Amount, ue.CompletionTime, ue.Partial, ue.Waiting, ue.BeginHeight)
+}
+
+// sort the unstaking entries by completion time, the waiting entries complete after the others so they come last,
+// sorted by begin height
+func SortUnstakingEntries(entries []UnstakingEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Waiting != entries[j].Waiting {
+			return entries[j].Waiting
+		}
+		if entries[i].Waiting {
+			return entries[i].BeginHeight < entries[j].BeginHeight
+		}
+		return entries[i].CompletionTime.Before(entries[j].CompletionTime)
+	})
+}
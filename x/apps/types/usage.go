@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"github.com/pokt-network/posmint/codec"
+	sdk "github.com/pokt-network/posmint/types"
+	"strings"
+)
+
+// Usage - the relays of an application for a chain in a session, claimed and proven by the servicers
+type Usage struct {
+	Address       sdk.Address `json:"address" yaml:"address"`               // the application serviced
+	Chain         string      `json:"chain" yaml:"chain"`                   // the chain serviced
+	SessionHeight int64       `json:"session_height" yaml:"session_height"` // the session block height
+	MaxRelays     sdk.Int     `json:"max_relays" yaml:"max_relays"`         // the relays allowed for the chain in the session
+	ClaimedRelays int64       `json:"claimed_relays" yaml:"claimed_relays"` // the relays claimed by the servicers
+	ProvenRelays  int64       `json:"proven_relays" yaml:"proven_relays"`   // the relays of the claims proven by the servicers
+}
+
+// HashString returns a human readable string representation of a relay usage.
+func (u Usage) String() string {
+	return fmt.Sprintf("Chain:\t\t\t%s\nSession Height:\t\t%d\nMax Relays:\t\t%s\nClaimed Relays:\t\t%d\nProven Relays:\t\t%d",
+		u.Chain, u.SessionHeight, u.MaxRelays, u.ClaimedRelays, u.ProvenRelays)
+}
+
+// MUST return the amino encoded version of this relay usage
+func MustMarshalUsage(cdc *codec.Codec, usage Usage) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(usage)
+}
+
+// MUST decode the relay usage from the bytes
+func MustUnmarshalUsage(cdc *codec.Codec, bz []byte) (usage Usage) {
+	cdc.MustUnmarshalBinaryLengthPrefixed(bz, &usage)
+	return
+}
+
+// ChainUsage - the relays of an application for a chain over the retained sessions
+type ChainUsage struct {
+	Chain         string  `json:"chain" yaml:"chain"`                   // the chain serviced
+	Sessions      int64   `json:"sessions" yaml:"sessions"`             // the sessions with relays for the chain
+	MaxRelays     sdk.Int `json:"max_relays" yaml:"max_relays"`         // the relays allowed over these sessions
+	ClaimedRelays int64   `json:"claimed_relays" yaml:"claimed_relays"` // the relays claimed by the servicers
+	ProvenRelays  int64   `json:"proven_relays" yaml:"proven_relays"`   // the relays of the claims proven by the servicers
+}
+
+// HashString returns a human readable string representation of the relay usage of a chain.
+func (cu ChainUsage) String() string {
+	return fmt.Sprintf("Chain:\t\t\t%s\nSessions:\t\t%d\nMax Relays:\t\t%s\nClaimed Relays:\t\t%d\nProven Relays:\t\t%d",
+		cu.Chain, cu.Sessions, cu.MaxRelays, cu.ClaimedRelays, cu.ProvenRelays)
+}
+
+// AppUsage - the relay usage of an application per session and per chain
+type AppUsage struct {
+	Address  sdk.Address  `json:"address" yaml:"address"`   // the application
+	Sessions []Usage      `json:"sessions" yaml:"sessions"` // the retained usage of each session and chain, sorted by session height
+	Chains   []ChainUsage `json:"chains" yaml:"chains"`     // the retained usage totals of each chain
+}
+
+// NewAppUsage returns the relay usage of an application with the totals of each chain
+func NewAppUsage(address sdk.Address, usages []Usage) AppUsage {
+	chains := make([]ChainUsage, 0)
+	index := make(map[string]int)
+	for _, u := range usages {
+		i, ok := index[u.Chain]
+		if !ok {
+			i = len(chains)
+			index[u.Chain] = i
+			chains = append(chains, ChainUsage{Chain: u.Chain, MaxRelays: sdk.ZeroInt()})
+		}
+		chains[i].Sessions++
+		chains[i].MaxRelays = chains[i].MaxRelays.Add(u.MaxRelays)
+		chains[i].ClaimedRelays += u.ClaimedRelays
+		chains[i].ProvenRelays += u.ProvenRelays
+	}
+	return AppUsage{
+		Address:  address,
+		Sessions: usages,
+		Chains:   chains,
+	}
+}
+
+// HashString returns a human readable string representation of the relay usage of an application.
+func (au AppUsage) String() string {
+	chains := make([]string, 0, len(au.Chains))
+	for _, cu := range au.Chains {
+		chains = append(chains, cu.String())
+	}
+	sessions := make([]string, 0, len(au.Sessions))
+	for _, u := range au.Sessions {
+		sessions = append(sessions, u.String())
+	}
+	return fmt.Sprintf("Address:\t\t%s\nChains:\n%s\n\nSessions:\n%s",
+		au.Address, strings.Join(chains, "\n\n"), strings.Join(sessions, "\n\n"))
+}
@@ -220,6 +220,9 @@ func getGenesisStateForTest(ctx sdk.Ctx, keeper keeper.Keeper, defaultparams boo
 		SigningInfos:             signingInfos,
 		MissedBlocks:             missedBlocks,
 		PreviousProposer:         prevProposer,
+		Delegations:              keeper.GetAllDelegations(ctx),
+		UnstakingDelegations:     keeper.GetAllUnstakingDelegations(ctx),
+		PartialUnstakes:          keeper.GetAllPartialUnstakes(ctx),
 	}
 
 }
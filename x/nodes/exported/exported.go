@@ -15,6 +15,7 @@ type ValidatorI interface {
 	GetChains() []string            // retrieve the staked chains
 	GetServiceURL() string          // retrieve the url for pocket core service api
 	GetAddress() sdk.Address        // address to receive/return validators coins
+	GetOutputAddress() sdk.Address  // address receiving the rewards and unstaked tokens of the validator
 	GetPublicKey() crypto.PublicKey // validator public key
 	GetTokens() sdk.Int             // validator tokens
 	GetConsensusPower() int64       // validator power in tendermint
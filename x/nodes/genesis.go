@@ -31,6 +31,10 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 		// set the validators from the data
 		keeper.SetValidator(ctx, validator)
 		keeper.SetStakedValidator(ctx, validator)
+		// index the consensus key of a validator that rotated its key
+		if consAddr := sdk.Address(validator.PublicKey.Address()); !consAddr.Equals(validator.Address) {
+			keeper.SetConsensusAddress(ctx, consAddr, validator.Address)
+		}
 		// ensure there's a signing info entry for the validator (used in slashing)
 		_, found := keeper.GetValidatorSigningInfo(ctx, validator.GetAddress())
 		if !found {
@@ -51,6 +55,20 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 			stakedTokens = stakedTokens.Add(validator.GetTokens())
 		}
 	}
+	// set the delegations from the data, the delegated tokens are part of the validators staked tokens
+	for _, delegation := range data.Delegations {
+		keeper.SetDelegation(ctx, delegation)
+	}
+	// the tokens of the unstaking delegations remain in the staked pool until returned
+	for _, ud := range data.UnstakingDelegations {
+		keeper.SetUnstakingDelegation(ctx, ud)
+		stakedTokens = stakedTokens.Add(ud.Amount)
+	}
+	// the tokens of the partial unstakes remain in the staked pool until released
+	for _, pu := range data.PartialUnstakes {
+		keeper.SetPartialUnstake(ctx, pu)
+		stakedTokens = stakedTokens.Add(pu.Amount)
+	}
 	// take the staked amount and create the corresponding coins object
 	stakedCoins := sdk.NewCoins(sdk.NewCoin(keeper.StakeDenom(ctx), stakedTokens))
 	// check if the staked pool accounts exists
@@ -140,6 +158,9 @@ func ExportGenesis(ctx sdk.Ctx, keeper keeper.Keeper) types.GenesisState {
 		return false
 	})
 	prevProposer := keeper.GetPreviousProposer(ctx)
+	delegations := keeper.GetAllDelegations(ctx)
+	unstakingDelegations := keeper.GetAllUnstakingDelegations(ctx)
+	partialUnstakes := keeper.GetAllPartialUnstakes(ctx)
 
 	return types.GenesisState{
 		Params:                   params,
@@ -150,6 +171,9 @@ func ExportGenesis(ctx sdk.Ctx, keeper keeper.Keeper) types.GenesisState {
 		SigningInfos:             signingInfos,
 		MissedBlocks:             missedBlocks,
 		PreviousProposer:         prevProposer,
+		Delegations:              delegations,
+		UnstakingDelegations:     unstakingDelegations,
+		PartialUnstakes:          partialUnstakes,
 	}
 }
 
@@ -19,6 +19,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgUnjail(ctx, msg, k)
 		case types.MsgSend:
 			return handleMsgSend(ctx, msg, k)
+		case types.MsgDelegate:
+			return handleMsgDelegate(ctx, msg, k)
+		case types.MsgUndelegate:
+			return handleMsgUndelegate(ctx, msg, k)
+		case types.MsgRedelegate:
+			return handleMsgRedelegate(ctx, msg, k)
+		case types.MsgSetCommission:
+			return handleMsgSetCommission(ctx, msg, k)
+		case types.MsgPartialUnstake:
+			return handleMsgPartialUnstake(ctx, msg, k)
+		case types.MsgRotateConsensusKey:
+			return handleMsgRotateConsensusKey(ctx, msg, k)
 		default:
 			errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -27,16 +39,34 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 }
 
 func handleStake(ctx sdk.Ctx, msg types.MsgStake, k keeper.Keeper) sdk.Result {
-	// create validator object using the message fields
-	validator := types.NewValidator(sdk.Address(msg.PublicKey.Address()), msg.PublicKey, msg.Chains, msg.ServiceURL, sdk.ZeroInt())
-	// check if they can stake
-	if err := k.ValidateValidatorStaking(ctx, validator, msg.Value); err != nil {
-		return err.Result()
-	}
-	// change the validator state to staked
-	err := k.StakeValidator(ctx, validator, msg.Value)
-	if err != nil {
-		return err.Result()
+	// create validator object using the message fields, a rotated consensus key resolves to its validator
+	address := k.ValidatorAddressFromConsensusAddress(ctx, sdk.Address(msg.PublicKey.Address()))
+	validator := types.NewValidator(address, msg.PublicKey, msg.Chains, msg.ServiceURL, sdk.ZeroInt())
+	validator.OutputAddress = msg.OutputAddress
+	signer := msg.GetSigners()[0]
+	// an already staked validator edits the stake, chains, service url and output address in place
+	if currentValidator, found := k.GetValidator(ctx, validator.Address); found && currentValidator.IsStaked() {
+		// check if they can edit
+		if err := k.ValidateEditStake(ctx, currentValidator, validator, msg.Value, signer); err != nil {
+			return err.Result()
+		}
+		// update the staked validator
+		if err := k.EditStakeValidator(ctx, currentValidator, validator, msg.Value); err != nil {
+			return err.Result()
+		}
+	} else {
+		// a new stake is funded by and must be signed by the validator itself
+		if !signer.Equals(validator.Address) {
+			return types.ErrUnauthorizedSigner(k.Codespace()).Result()
+		}
+		// check if they can stake
+		if err := k.ValidateValidatorStaking(ctx, validator, msg.Value); err != nil {
+			return err.Result()
+		}
+		// change the validator state to staked
+		if err := k.StakeValidator(ctx, validator, msg.Value); err != nil {
+			return err.Result()
+		}
 	}
 	// create the event
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -63,6 +93,10 @@ func handleMsgBeginUnstake(ctx sdk.Ctx, msg types.MsgBeginUnstake, k keeper.Keep
 	if !found {
 		return types.ErrNoValidatorFound(k.Codespace()).Result()
 	}
+	// only the output address may unstake, the validator address if no output address is set
+	if !msg.GetSigners()[0].Equals(validator.GetOutputAddress()) {
+		return types.ErrUnauthorizedSigner(k.Codespace()).Result()
+	}
 	if err := k.ValidateValidatorBeginUnstaking(ctx, validator); err != nil {
 		return err.Result()
 	}
@@ -75,6 +109,69 @@ func handleMsgBeginUnstake(ctx sdk.Ctx, msg types.MsgBeginUnstake, k keeper.Keep
 			types.EventTypeWaitingToBeginUnstaking,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, validator.StakedTokens.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgPartialUnstake(ctx sdk.Ctx, msg types.MsgPartialUnstake, k keeper.Keeper) sdk.Result {
+	ctx.Logger().Info("Partial Unstake Message received from " + msg.Address.String())
+	validator, found := k.GetValidator(ctx, msg.Address)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace()).Result()
+	}
+	// only the output address may unstake, the validator address if no output address is set
+	if !msg.GetSigners()[0].Equals(validator.GetOutputAddress()) {
+		return types.ErrUnauthorizedSigner(k.Codespace()).Result()
+	}
+	if err := k.ValidatePartialUnstake(ctx, validator, msg.Amount); err != nil {
+		return err.Result()
+	}
+	k.PartialUnstakeValidator(ctx, validator, msg.Amount)
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePartialUnstake,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRotateConsensusKey(ctx sdk.Ctx, msg types.MsgRotateConsensusKey, k keeper.Keeper) sdk.Result {
+	ctx.Logger().Info("Rotate Consensus Key Message received from " + msg.Address.String())
+	validator, found := k.GetValidator(ctx, msg.Address)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace()).Result()
+	}
+	// only the output address may rotate the key, the validator address if no output address is set
+	if !msg.GetSigners()[0].Equals(validator.GetOutputAddress()) {
+		return types.ErrUnauthorizedSigner(k.Codespace()).Result()
+	}
+	if err := k.ValidateRotateConsensusKey(ctx, validator, msg.PublicKey); err != nil {
+		return err.Result()
+	}
+	k.RotateConsensusKey(ctx, validator, msg.PublicKey)
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRotateConsensusKey,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyConsensusKey, msg.PublicKey.RawString()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -118,3 +215,119 @@ func handleMsgSend(ctx sdk.Ctx, msg types.MsgSend, k keeper.Keeper) sdk.Result {
 	)
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+func handleMsgDelegate(ctx sdk.Ctx, msg types.MsgDelegate, k keeper.Keeper) sdk.Result {
+	ctx.Logger().Info("Delegate Message received from " + msg.DelegatorAddress.String())
+	validator, found := k.GetValidator(ctx, msg.ValidatorAddress)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace()).Result()
+	}
+	if err := k.ValidateDelegate(ctx, msg.DelegatorAddress, validator, msg.Amount); err != nil {
+		return err.Result()
+	}
+	if err := k.Delegate(ctx, msg.DelegatorAddress, validator, msg.Amount); err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelegate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgUndelegate(ctx sdk.Ctx, msg types.MsgUndelegate, k keeper.Keeper) sdk.Result {
+	ctx.Logger().Info("Undelegate Message received from " + msg.DelegatorAddress.String())
+	validator, found := k.GetValidator(ctx, msg.ValidatorAddress)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace()).Result()
+	}
+	shares, err := k.ValidateUndelegate(ctx, msg.DelegatorAddress, validator, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	amount := k.Undelegate(ctx, msg.DelegatorAddress, validator, shares)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUndelegate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRedelegate(ctx sdk.Ctx, msg types.MsgRedelegate, k keeper.Keeper) sdk.Result {
+	ctx.Logger().Info("Redelegate Message received from " + msg.DelegatorAddress.String())
+	srcValidator, found := k.GetValidator(ctx, msg.ValidatorSrcAddress)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace()).Result()
+	}
+	dstValidator, found := k.GetValidator(ctx, msg.ValidatorDstAddress)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace()).Result()
+	}
+	shares, err := k.ValidateRedelegate(ctx, msg.DelegatorAddress, srcValidator, dstValidator, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	amount, err := k.Redelegate(ctx, msg.DelegatorAddress, srcValidator, dstValidator, shares)
+	if err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedelegate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySrcValidator, msg.ValidatorSrcAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyDstValidator, msg.ValidatorDstAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgSetCommission(ctx sdk.Ctx, msg types.MsgSetCommission, k keeper.Keeper) sdk.Result {
+	ctx.Logger().Info("Set Commission Message received for " + msg.Address.String())
+	validator, found := k.GetValidator(ctx, msg.Address)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace()).Result()
+	}
+	if err := k.ValidateSetCommission(ctx, validator, msg.Commission, msg.GetSigners()[0]); err != nil {
+		return err.Result()
+	}
+	k.SetCommission(ctx, validator, msg.Commission)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetCommission,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyCommission, msg.Commission.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.GetSigners()[0].String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
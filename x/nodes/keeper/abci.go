@@ -12,6 +12,10 @@ import (
 // 3) set new proposer
 // 4) check block sigs and byzantine evidence to slash
 func BeginBlocker(ctx sdk.Ctx, req abci.RequestBeginBlock, k Keeper) {
+	// backfill the defaults of the params added by the upgrade before they are read
+	k.BackfillParams(ctx)
+	// backfill the chain index of the validators staked before the upgrade
+	k.BuildChainIndex(ctx)
 	// reward the proposer with fees
 	if ctx.BlockHeight() > 1 {
 		previousProposer := k.GetPreviousProposer(ctx)
@@ -21,8 +25,10 @@ func BeginBlocker(ctx sdk.Ctx, req abci.RequestBeginBlock, k Keeper) {
 	k.mintNodeRelayRewards(ctx)
 	// burn any custom validator slashes
 	k.burnValidators(ctx)
+	// delete the earnings past the retention
+	k.pruneEarnings(ctx)
 	// record the new proposer for when we payout on the next block
-	addr := sdk.Address(req.Header.ProposerAddress)
+	addr := k.ValidatorAddressFromConsensusAddress(ctx, sdk.Address(req.Header.ProposerAddress))
 	k.SetPreviousProposer(ctx, addr)
 	// Iterate over all the validators which *should* have signed this block
 	// store whether or not they have actually signed it and slash/unstake any
@@ -50,5 +56,9 @@ func EndBlocker(ctx sdk.Ctx, k Keeper) []abci.ValidatorUpdate {
 	validatorUpdates := k.UpdateTendermintValidators(ctx)
 	// Unstake all mature validators from the unstakeing queue.
 	k.unstakeAllMatureValidators(ctx)
+	// Release the tokens of all mature partial unstakes.
+	k.unstakeAllMaturePartialUnstakes(ctx)
+	// Return the tokens of all mature unstaking delegations.
+	k.unstakeAllMatureDelegations(ctx)
 	return validatorUpdates
 }
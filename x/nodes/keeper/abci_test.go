@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/posmint/store/prefix"
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/stretchr/testify/assert"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -39,6 +41,19 @@ func TestBeginBlocker(t *testing.T) {
 	}
 }
 
+func TestBeginBlocker_BackfillParams(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	// a chain started before the earnings retention param existed
+	store := prefix.NewStore(context.KVStore(sdk.ParamsKey), append([]byte(types.DefaultParamspace), '/'))
+	store.Delete(types.KeyEarningsRetention)
+	assert.False(t, keeper.Paramstore.Has(context, types.KeyEarningsRetention))
+	// the param is backfilled before the earnings are pruned
+	BeginBlocker(context, abci.RequestBeginBlock{}, keeper)
+	assert.Equal(t, types.DefaultEarningsRetention, keeper.EarningsRetention(context))
+	// the params already set are kept
+	assert.Equal(t, types.DefaultParams().StakeDenom, keeper.StakeDenom(context))
+}
+
 func TestEndBlocker(t *testing.T) {
 	type args struct {
 		ctx sdk.Context
@@ -157,13 +157,16 @@ func getRandomValidatorAddress() sdk.Address {
 func getValidator() types.Validator {
 	pub := getRandomPubKey()
 	return types.Validator{
-		Address:      sdk.Address(pub.Address()),
-		StakedTokens: sdk.NewInt(100000000000),
-		PublicKey:    pub,
-		Jailed:       false,
-		Status:       sdk.Staked,
-		ServiceURL:   "google.com",
-		Chains:       []string{"b60d7bdd334cd3768d43f14a05c7fe7e886ba5bcb77e1064530052fed1a3f145"},
+		Address:         sdk.Address(pub.Address()),
+		StakedTokens:    sdk.NewInt(100000000000),
+		PublicKey:       pub,
+		Jailed:          false,
+		Status:          sdk.Staked,
+		ServiceURL:      "google.com",
+		Chains:          []string{"b60d7bdd334cd3768d43f14a05c7fe7e886ba5bcb77e1064530052fed1a3f145"},
+		DelegatedTokens: sdk.ZeroInt(),
+		DelegatorShares: sdk.ZeroDec(),
+		Commission:      sdk.ZeroDec(),
 	}
 }
 
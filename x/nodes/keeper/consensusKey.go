@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/posmint/crypto"
+	sdk "github.com/pokt-network/posmint/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/common"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// returns the address of the validator that signs with the consensus address,
+// the consensus address itself if the validator never rotated its consensus key
+func (k Keeper) ValidatorAddressFromConsensusAddress(ctx sdk.Ctx, consAddr sdk.Address) sdk.Address {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyForConsensusAddress(consAddr))
+	if bz == nil {
+		return consAddr
+	}
+	return sdk.Address(bz)
+}
+
+// set the validator address of a rotated consensus key
+func (k Keeper) SetConsensusAddress(ctx sdk.Ctx, consAddr, addr sdk.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyForConsensusAddress(consAddr), addr)
+}
+
+// get the consensus key known by tendermint for a validator that rotated its key in this block
+func (k Keeper) getPrevStateConsensusKey(ctx sdk.Ctx, addr sdk.Address) (pk crypto.PublicKey, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyForPrevStateConsensusKey(addr))
+	if bz == nil {
+		return nil, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &pk)
+	return pk, true
+}
+
+// set the consensus key known by tendermint for a validator that rotated its key in this block
+func (k Keeper) setPrevStateConsensusKey(ctx sdk.Ctx, addr sdk.Address, pk crypto.PublicKey) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyForPrevStateConsensusKey(addr), k.cdc.MustMarshalBinaryBare(pk))
+}
+
+// returns the tendermint removals of the consensus keys replaced in this block and clears them,
+// the validators are removed from the prevState so their new keys are sent with their current power
+func (k Keeper) removeRotatedConsensusKeys(ctx sdk.Ctx, prevStatePowerMap valPowerMap) (updates []abci.ValidatorUpdate) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PrevStateConsensusKeyKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		addr := sdk.Address(types.AddressFromKey(iterator.Key()))
+		var pk crypto.PublicKey
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &pk)
+		var valAddrBytes [sdk.AddrLen]byte
+		copy(valAddrBytes[:], addr)
+		// only the keys of the validators in the prevState validator set are known by tendermint
+		if _, found := prevStatePowerMap[valAddrBytes]; found {
+			updates = append(updates, abci.ValidatorUpdate{PubKey: tmtypes.TM2PB.PubKey(pk.PubKey()), Power: 0})
+			delete(prevStatePowerMap, valAddrBytes)
+			k.DeletePrevStateValPower(ctx, addr)
+		}
+		store.Delete(iterator.Key())
+	}
+	return updates
+}
+
+// validate check called before a validator rotates its consensus key
+func (k Keeper) ValidateRotateConsensusKey(ctx sdk.Ctx, validator types.Validator, publicKey crypto.PublicKey) sdk.Error {
+	if validator.IsUnstaked() {
+		return types.ErrValidatorStatus(k.codespace)
+	}
+	if validator.PublicKey.Equals(publicKey) {
+		return types.ErrValidatorPubKeyExists(k.codespace)
+	}
+	// the new key must not belong to another validator, tendermint requires unique consensus keys
+	consAddr := sdk.Address(publicKey.Address())
+	if addr := k.ValidatorAddressFromConsensusAddress(ctx, consAddr); !addr.Equals(validator.Address) {
+		if _, found := k.GetValidator(ctx, addr); found {
+			return types.ErrValidatorPubKeyExists(k.codespace)
+		}
+	}
+	// check the consensus params
+	if ctx.ConsensusParams() != nil {
+		tmPubKey, err := crypto.CheckConsensusPubKey(publicKey.PubKey())
+		if err != nil {
+			return types.ErrValidatorPubKeyTypeNotSupported(k.Codespace(),
+				err.Error(),
+				ctx.ConsensusParams().Validator.PubKeyTypes)
+		}
+		if !common.StringInSlice(tmPubKey.Type, ctx.ConsensusParams().Validator.PubKeyTypes) {
+			return types.ErrValidatorPubKeyTypeNotSupported(k.Codespace(),
+				tmPubKey.Type,
+				ctx.ConsensusParams().Validator.PubKeyTypes)
+		}
+	}
+	return nil
+}
+
+// store ops when a validator rotates its consensus key -> the address, stake and signing info are unchanged
+// NOTE the key swap is sent to tendermint in the end blocker by UpdateTendermintValidators
+func (k Keeper) RotateConsensusKey(ctx sdk.Ctx, validator types.Validator, publicKey crypto.PublicKey) {
+	// keep the key known by tendermint, only the first rotation within a block reaches tendermint
+	if _, found := k.getPrevStateConsensusKey(ctx, validator.Address); !found {
+		k.setPrevStateConsensusKey(ctx, validator.Address, validator.PublicKey)
+	}
+	validator.PublicKey = publicKey
+	k.SetValidator(ctx, validator)
+	// votes, evidence and relays signed with the new key resolve to the validator
+	if consAddr := sdk.Address(publicKey.Address()); !consAddr.Equals(validator.Address) {
+		k.SetConsensusAddress(ctx, consAddr, validator.Address)
+	}
+	ctx.Logger().Info("Rotated the consensus key of validator " + validator.Address.String() + " to " + publicKey.RawString())
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/posmint/crypto"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"testing"
+)
+
+func TestKeeper_ValidateRotateConsensusKey(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	other := getStakedValidator()
+	keeper.SetValidator(context, validator)
+	keeper.SetValidator(context, other)
+	tests := []struct {
+		name      string
+		validator types.Validator
+		publicKey crypto.PublicKey
+		err       sdk.Error
+	}{
+		{"validates a new key", validator, getRandomPubKey(), nil},
+		{"errors if validator is unstaked", getUnstakedValidator(), getRandomPubKey(), types.ErrValidatorStatus(types.DefaultCodespace)},
+		{"errors if the key is unchanged", validator, validator.PublicKey, types.ErrValidatorPubKeyExists(types.DefaultCodespace)},
+		{"errors if the key belongs to another validator", validator, other.PublicKey, types.ErrValidatorPubKeyExists(types.DefaultCodespace)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.err, keeper.ValidateRotateConsensusKey(context, test.validator, test.publicKey))
+		})
+	}
+}
+
+func TestKeeper_RotateConsensusKey(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getUnstakedValidator()
+	validator.StakedTokens = sdk.ZeroInt()
+	stakeAmount := sdk.NewInt(100000000000)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, validator.Address, stakeAmount)
+	assert.Nil(t, keeper.StakeValidator(context, validator, stakeAmount))
+	keeper.UpdateTendermintValidators(context)
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	oldKey := validator.PublicKey
+	newKey := getRandomPubKey()
+	assert.Nil(t, keeper.ValidateRotateConsensusKey(context, validator, newKey))
+	keeper.RotateConsensusKey(context, validator, newKey)
+	updated, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	assert.True(t, updated.PublicKey.Equals(newKey))
+	assert.True(t, updated.StakedTokens.Equal(stakeAmount))
+	// the new consensus address resolves to the validator
+	newConsAddr := sdk.Address(newKey.Address())
+	assert.Equal(t, validator.Address, keeper.ValidatorAddressFromConsensusAddress(context, newConsAddr))
+	assert.Equal(t, validator.Address, keeper.Validator(context, newConsAddr).GetAddress())
+	assert.Equal(t, validator.Address, keeper.Validator(context, validator.Address).GetAddress())
+	// tendermint removes the old key and adds the new key with the same power
+	updates := keeper.UpdateTendermintValidators(context)
+	assert.Len(t, updates, 2)
+	assert.Equal(t, tmtypes.TM2PB.PubKey(oldKey.PubKey()), updates[0].PubKey)
+	assert.Equal(t, int64(0), updates[0].Power)
+	assert.Equal(t, tmtypes.TM2PB.PubKey(newKey.PubKey()), updates[1].PubKey)
+	assert.Equal(t, updated.ConsensusPower(), updates[1].Power)
+	// nothing left to send in the next block
+	assert.Len(t, keeper.UpdateTendermintValidators(context), 0)
+}
@@ -0,0 +1,397 @@
+package keeper
+
+import (
+	"fmt"
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// set a delegation in the store
+func (k Keeper) SetDelegation(ctx sdk.Ctx, delegation types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalDelegation(k.cdc, delegation)
+	store.Set(types.KeyForDelegation(delegation.ValidatorAddress, delegation.DelegatorAddress), bz)
+	store.Set(types.KeyForDelegationByDelegator(delegation.DelegatorAddress, delegation.ValidatorAddress), delegation.ValidatorAddress)
+}
+
+// get the delegation of delegator to validator
+func (k Keeper) GetDelegation(ctx sdk.Ctx, validator, delegator sdk.Address) (delegation types.Delegation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyForDelegation(validator, delegator))
+	if bz == nil {
+		return delegation, false
+	}
+	return types.MustUnmarshalDelegation(k.cdc, bz), true
+}
+
+// delete a delegation from the store
+func (k Keeper) deleteDelegation(ctx sdk.Ctx, delegation types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyForDelegation(delegation.ValidatorAddress, delegation.DelegatorAddress))
+	store.Delete(types.KeyForDelegationByDelegator(delegation.DelegatorAddress, delegation.ValidatorAddress))
+}
+
+// get all of the delegations to a validator
+func (k Keeper) GetValidatorDelegations(ctx sdk.Ctx, validator sdk.Address) (delegations []types.Delegation) {
+	delegations = make([]types.Delegation, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyForDelegationsByValidator(validator))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		delegations = append(delegations, types.MustUnmarshalDelegation(k.cdc, iterator.Value()))
+	}
+	return delegations
+}
+
+// get all of the delegations of a delegator through the delegator index
+func (k Keeper) GetDelegatorDelegations(ctx sdk.Ctx, delegator sdk.Address) (delegations []types.Delegation) {
+	delegations = make([]types.Delegation, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyForDelegationsByDelegator(delegator))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if delegation, found := k.GetDelegation(ctx, iterator.Value(), delegator); found {
+			delegations = append(delegations, delegation)
+		}
+	}
+	return delegations
+}
+
+// get all of the delegations
+func (k Keeper) GetAllDelegations(ctx sdk.Ctx) (delegations []types.Delegation) {
+	delegations = make([]types.Delegation, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegationKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		delegations = append(delegations, types.MustUnmarshalDelegation(k.cdc, iterator.Value()))
+	}
+	return delegations
+}
+
+// set an unstaking delegation in the store, adding to the amount of an entry with the same completion time
+func (k Keeper) SetUnstakingDelegation(ctx sdk.Ctx, ud types.UnstakingDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyForUnstakingDelegation(ud.CompletionTime, ud.DelegatorAddress, ud.ValidatorAddress)
+	if bz := store.Get(key); bz != nil {
+		ud.Amount = ud.Amount.Add(types.MustUnmarshalUnstakingDelegation(k.cdc, bz).Amount)
+	}
+	store.Set(key, types.MustMarshalUnstakingDelegation(k.cdc, ud))
+}
+
+// get all of the unstaking delegations of a delegator
+func (k Keeper) GetDelegatorUnstakingDelegations(ctx sdk.Ctx, delegator sdk.Address) (uds []types.UnstakingDelegation) {
+	uds = make([]types.UnstakingDelegation, 0)
+	for _, ud := range k.GetAllUnstakingDelegations(ctx) {
+		if ud.DelegatorAddress.Equals(delegator) {
+			uds = append(uds, ud)
+		}
+	}
+	return uds
+}
+
+// get all of the unstaking delegations sorted by completion time
+func (k Keeper) GetAllUnstakingDelegations(ctx sdk.Ctx) (uds []types.UnstakingDelegation) {
+	uds = make([]types.UnstakingDelegation, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.UnstakingDelegationKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		uds = append(uds, types.MustUnmarshalUnstakingDelegation(k.cdc, iterator.Value()))
+	}
+	return uds
+}
+
+// burn the slash factor of the tokens undelegated from a validator at or after the infraction height, they were part of
+// the power of the validator at the infraction, returns the burned tokens
+func (k Keeper) slashUnstakingDelegations(ctx sdk.Ctx, validator sdk.Address, infractionHeight int64, slashFactor sdk.Dec) sdk.Int {
+	burned := sdk.ZeroInt()
+	store := ctx.KVStore(k.storeKey)
+	for _, ud := range k.GetAllUnstakingDelegations(ctx) {
+		if !ud.ValidatorAddress.Equals(validator) || ud.CreationHeight < infractionHeight {
+			continue
+		}
+		slashAmount := sdk.MinInt(slashFactor.MulInt(ud.Amount).TruncateInt(), ud.Amount)
+		if !slashAmount.IsPositive() {
+			continue
+		}
+		key := types.KeyForUnstakingDelegation(ud.CompletionTime, ud.DelegatorAddress, ud.ValidatorAddress)
+		ud.Amount = ud.Amount.Sub(slashAmount)
+		if ud.Amount.IsZero() {
+			store.Delete(key)
+		} else {
+			store.Set(key, types.MustMarshalUnstakingDelegation(k.cdc, ud))
+		}
+		burned = burned.Add(slashAmount)
+	}
+	if err := k.burnStakedTokens(ctx, burned); err != nil {
+		panic(err)
+	}
+	return burned
+}
+
+// return the tokens of all the mature unstaking delegations to the delegators -> called in the end blocker
+func (k Keeper) unstakeAllMatureDelegations(ctx sdk.Ctx) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.UnstakingDelegationKey, sdk.PrefixEndBytes(types.KeyForUnstakingDelegations(ctx.BlockHeader().Time)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		ud := types.MustUnmarshalUnstakingDelegation(k.cdc, iterator.Value())
+		coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), ud.Amount))
+		if err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, ud.DelegatorAddress, coins); err != nil {
+			panic(err)
+		}
+		store.Delete(iterator.Key())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteUndelegation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyDelegator, ud.DelegatorAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, ud.ValidatorAddress.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, ud.Amount.String()),
+			),
+		)
+		ctx.Logger().Info("Finished unstaking delegation of " + ud.DelegatorAddress.String() + " to " + ud.ValidatorAddress.String())
+	}
+}
+
+// validate check called before a delegator delegates tokens to a validator
+func (k Keeper) ValidateDelegate(ctx sdk.Ctx, delegator sdk.Address, validator types.Validator, amount sdk.Int) sdk.Error {
+	if err := k.validateDelegationTarget(ctx, delegator, validator); err != nil {
+		return err
+	}
+	if _, err := validator.SharesFromTokens(amount); err != nil {
+		return err
+	}
+	coin := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), amount))
+	if !k.AccountKeeper.HasCoins(ctx, delegator, coin) {
+		return types.ErrNotEnoughCoins(k.codespace)
+	}
+	return nil
+}
+
+// only staked, unjailed validators that are not waiting to unstake may receive delegations
+func (k Keeper) validateDelegationTarget(ctx sdk.Ctx, delegator sdk.Address, validator types.Validator) sdk.Error {
+	if delegator.Equals(validator.Address) {
+		return types.ErrSelfDelegation(k.codespace)
+	}
+	if !validator.IsStaked() {
+		return types.ErrValidatorStatus(k.codespace)
+	}
+	if validator.IsJailed() {
+		return types.ErrValidatorJailed(k.codespace)
+	}
+	if k.IsWaitingValidator(ctx, validator.Address) {
+		return types.ErrValidatorWaitingToUnstake(k.codespace)
+	}
+	return nil
+}
+
+// store ops when a delegator delegates tokens to a validator
+func (k Keeper) Delegate(ctx sdk.Ctx, delegator sdk.Address, validator types.Validator, amount sdk.Int) sdk.Error {
+	coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), amount))
+	if err := k.AccountKeeper.SendCoinsFromAccountToModule(ctx, delegator, types.StakedPoolName, coins); err != nil {
+		return err
+	}
+	_, err := k.addDelegation(ctx, delegator, validator, amount)
+	if err != nil {
+		return err
+	}
+	ctx.Logger().Info("Successfully delegated " + amount.String() + " from " + delegator.String() + " to " + validator.Address.String())
+	return nil
+}
+
+// issue shares of the validator for the tokens and update the validator and its power index
+func (k Keeper) addDelegation(ctx sdk.Ctx, delegator sdk.Address, validator types.Validator, amount sdk.Int) (types.Validator, sdk.Error) {
+	// remove the old power index entry before the tokens change
+	k.deleteValidatorFromStakingSet(ctx, validator)
+	validator, shares, err := validator.AddDelegatedTokens(amount)
+	if err != nil {
+		k.SetStakedValidator(ctx, validator)
+		return validator, err
+	}
+	k.SetValidator(ctx, validator)
+	k.SetStakedValidator(ctx, validator)
+	delegation, found := k.GetDelegation(ctx, validator.Address, delegator)
+	if !found {
+		delegation = types.NewDelegation(delegator, validator.Address, sdk.ZeroDec())
+	}
+	delegation.Shares = delegation.Shares.Add(shares)
+	k.SetDelegation(ctx, delegation)
+	return validator, nil
+}
+
+// validate check called before a delegator undelegates tokens, returns the shares the tokens are worth
+func (k Keeper) ValidateUndelegate(ctx sdk.Ctx, delegator sdk.Address, validator types.Validator, amount sdk.Int) (sdk.Dec, sdk.Error) {
+	delegation, found := k.GetDelegation(ctx, validator.Address, delegator)
+	if !found {
+		return sdk.ZeroDec(), types.ErrNoDelegation(k.codespace)
+	}
+	delegated := validator.TokensFromShares(delegation.Shares).TruncateInt()
+	if amount.GT(delegated) {
+		return sdk.ZeroDec(), types.ErrNotEnoughShares(k.codespace)
+	}
+	// undelegating everything removes all of the shares so no dust is left behind
+	if amount.Equal(delegated) {
+		return delegation.Shares, nil
+	}
+	shares, err := validator.SharesFromTokens(amount)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	return sdk.MinDec(shares, delegation.Shares), nil
+}
+
+// store ops when a delegator undelegates shares -> the tokens are returned after the unstaking time
+func (k Keeper) Undelegate(ctx sdk.Ctx, delegator sdk.Address, validator types.Validator, shares sdk.Dec) sdk.Int {
+	_, amount := k.removeDelegation(ctx, delegator, validator, shares)
+	k.SetUnstakingDelegation(ctx, types.UnstakingDelegation{
+		DelegatorAddress: delegator,
+		ValidatorAddress: validator.Address,
+		Amount:           amount,
+		CompletionTime:   ctx.BlockHeader().Time.Add(k.UnStakingTime(ctx)),
+		CreationHeight:   ctx.BlockHeight(),
+	})
+	ctx.Logger().Info("Began unstaking delegation of " + amount.String() + " from " + delegator.String() + " to " + validator.Address.String())
+	return amount
+}
+
+// remove shares of the validator, update the validator and its power index and return the tokens they were worth
+func (k Keeper) removeDelegation(ctx sdk.Ctx, delegator sdk.Address, validator types.Validator, shares sdk.Dec) (types.Validator, sdk.Int) {
+	// remove the old power index entry before the tokens change
+	k.deleteValidatorFromStakingSet(ctx, validator)
+	validator, amount := validator.RemoveDelegatorShares(shares)
+	k.SetValidator(ctx, validator)
+	// a jailed validator stays out of the power index until it is unjailed
+	if validator.IsStaked() && !validator.IsJailed() {
+		k.SetStakedValidator(ctx, validator)
+	}
+	delegation, _ := k.GetDelegation(ctx, validator.Address, delegator)
+	delegation.Shares = delegation.Shares.Sub(shares)
+	if delegation.Shares.IsPositive() {
+		k.SetDelegation(ctx, delegation)
+	} else {
+		k.deleteDelegation(ctx, delegation)
+	}
+	return validator, amount
+}
+
+// validate check called before a delegator moves delegated tokens from one validator to another
+func (k Keeper) ValidateRedelegate(ctx sdk.Ctx, delegator sdk.Address, srcValidator, dstValidator types.Validator, amount sdk.Int) (sdk.Dec, sdk.Error) {
+	if srcValidator.Address.Equals(dstValidator.Address) {
+		return sdk.ZeroDec(), types.ErrInvalidRedelegation(k.codespace)
+	}
+	if err := k.validateDelegationTarget(ctx, delegator, dstValidator); err != nil {
+		return sdk.ZeroDec(), err
+	}
+	return k.ValidateUndelegate(ctx, delegator, srcValidator, amount)
+}
+
+// store ops when a delegator moves delegated shares from one validator to another, the tokens stay staked
+func (k Keeper) Redelegate(ctx sdk.Ctx, delegator sdk.Address, srcValidator, dstValidator types.Validator, shares sdk.Dec) (sdk.Int, sdk.Error) {
+	_, amount := k.removeDelegation(ctx, delegator, srcValidator, shares)
+	if _, err := k.addDelegation(ctx, delegator, dstValidator, amount); err != nil {
+		return sdk.ZeroInt(), err
+	}
+	ctx.Logger().Info("Successfully redelegated " + amount.String() + " from " + srcValidator.Address.String() + " to " + dstValidator.Address.String())
+	return amount, nil
+}
+
+// validate check called before a validator sets its commission rate
+func (k Keeper) ValidateSetCommission(ctx sdk.Ctx, validator types.Validator, commission sdk.Dec, signer sdk.Address) sdk.Error {
+	// the commission is income of the output address so only the output address may set it
+	if !signer.Equals(validator.GetOutputAddress()) {
+		return types.ErrUnauthorizedSigner(k.codespace)
+	}
+	if commission.IsNegative() || commission.GT(sdk.OneDec()) {
+		return types.ErrInvalidCommission(k.codespace)
+	}
+	return nil
+}
+
+// store ops when a validator sets its commission rate
+func (k Keeper) SetCommission(ctx sdk.Ctx, validator types.Validator, commission sdk.Dec) {
+	validator.Commission = commission
+	k.SetValidator(ctx, validator)
+	ctx.Logger().Info("Set the commission of validator " + validator.Address.String() + " to " + commission.String())
+}
+
+// return the tokens of all the delegations of a validator to the delegators -> used when the validator unstakes
+func (k Keeper) payoutDelegations(ctx sdk.Ctx, validator types.Validator) types.Validator {
+	for _, delegation := range k.GetValidatorDelegations(ctx, validator.Address) {
+		var amount sdk.Int
+		validator, amount = validator.RemoveDelegatorShares(delegation.Shares)
+		if amount.IsPositive() {
+			coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), amount))
+			if err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, delegation.DelegatorAddress, coins); err != nil {
+				panic(err)
+			}
+		}
+		k.deleteDelegation(ctx, delegation)
+		ctx.Logger().Info(fmt.Sprintf("returned %s delegated tokens of %s from unstaked validator %s", amount, delegation.DelegatorAddress, validator.Address))
+	}
+	return validator
+}
+
+// queue the delegations of a validator as unstaking delegations -> used when the validator is force unstaked,
+// the delegated tokens are returned after the unstaking time like an undelegation
+func (k Keeper) unstakeDelegations(ctx sdk.Ctx, validator types.Validator) types.Validator {
+	completionTime := ctx.BlockHeader().Time.Add(k.UnStakingTime(ctx))
+	for _, delegation := range k.GetValidatorDelegations(ctx, validator.Address) {
+		var amount sdk.Int
+		validator, amount = validator.RemoveDelegatorShares(delegation.Shares)
+		if amount.IsPositive() {
+			k.SetUnstakingDelegation(ctx, types.UnstakingDelegation{
+				DelegatorAddress: delegation.DelegatorAddress,
+				ValidatorAddress: validator.Address,
+				Amount:           amount,
+				CompletionTime:   completionTime,
+				CreationHeight:   ctx.BlockHeight(),
+			})
+		}
+		k.deleteDelegation(ctx, delegation)
+		ctx.Logger().Info(fmt.Sprintf("began unstaking %s delegated tokens of %s from force unstaked validator %s", amount, delegation.DelegatorAddress, validator.Address))
+	}
+	return validator
+}
+
+// mint the relay reward of a validator, the delegators receive their share of the reward minus the commission
+// returns the reward minted to the validator itself
+func (k Keeper) distributeRelayReward(ctx sdk.Ctx, address sdk.Address, amount sdk.Int) (validatorReward sdk.Int) {
+	validator, found := k.GetValidator(ctx, address)
+	if !found {
+		k.mint(ctx, amount, address)
+		return amount
+	}
+	delegated := validator.GetDelegatedTokens()
+	if !delegated.IsPositive() || !validator.StakedTokens.IsPositive() {
+		k.mint(ctx, amount, validator.GetOutputAddress())
+		return amount
+	}
+	// the delegators earn pro rata of the delegated tokens and the validator keeps the commission of it
+	delegatorsReward := amount.Mul(delegated).Quo(validator.StakedTokens) // truncates
+	commission := validator.GetCommission().MulInt(delegatorsReward).TruncateInt()
+	delegatorsReward = delegatorsReward.Sub(commission)
+	totalShares := validator.GetDelegatorShares()
+	paid := sdk.ZeroInt()
+	for _, delegation := range k.GetValidatorDelegations(ctx, validator.Address) {
+		reward := delegation.Shares.MulInt(delegatorsReward).Quo(totalShares).TruncateInt()
+		if !reward.IsPositive() {
+			continue
+		}
+		k.mint(ctx, reward, delegation.DelegatorAddress)
+		paid = paid.Add(reward)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDelegatorReward,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, reward.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, delegation.DelegatorAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, validator.Address.String()),
+			),
+		)
+	}
+	// the validator receives its own share, the commission and any truncated remainder
+	validatorReward = amount.Sub(paid)
+	k.mint(ctx, validatorReward, validator.GetOutputAddress())
+	return validatorReward
+}
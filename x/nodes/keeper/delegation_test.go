@@ -0,0 +1,299 @@
+package keeper
+
+import (
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func setupDelegationValidator(t *testing.T, ctx sdk.Context, keeper *Keeper) types.Validator {
+	validator := getStakedValidator()
+	keeper.SetValidator(ctx, validator)
+	keeper.SetStakedValidator(ctx, validator)
+	addMintedCoinsToModule(t, ctx, keeper, types.StakedPoolName)
+	return validator
+}
+
+func TestKeeper_ValidateDelegate(t *testing.T) {
+	context, accs, keeper := createTestInput(t, true)
+	validator := setupDelegationValidator(t, context, &keeper)
+	delegator := accs[0].GetAddress()
+	jailed := getStakedValidator()
+	jailed.Jailed = true
+	unstaked := getUnstakedValidator()
+	tests := []struct {
+		name      string
+		delegator sdk.Address
+		validator types.Validator
+		amount    sdk.Int
+		err       sdk.Error
+	}{
+		{"validates delegation", delegator, validator, sdk.NewInt(1000), nil},
+		{"errors on self delegation", validator.Address, validator, sdk.NewInt(1000), types.ErrSelfDelegation(types.DefaultCodespace)},
+		{"errors on jailed validator", delegator, jailed, sdk.NewInt(1000), types.ErrValidatorJailed(types.DefaultCodespace)},
+		{"errors on unstaked validator", delegator, unstaked, sdk.NewInt(1000), types.ErrValidatorStatus(types.DefaultCodespace)},
+		{"errors without the coins", getRandomValidatorAddress(), validator, sdk.NewInt(1000), types.ErrNotEnoughCoins(types.DefaultCodespace)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := keeper.ValidateDelegate(context, test.delegator, test.validator, test.amount)
+			assert.Equal(t, test.err, err)
+		})
+	}
+}
+
+func TestKeeper_Delegate(t *testing.T) {
+	context, accs, keeper := createTestInput(t, true)
+	validator := setupDelegationValidator(t, context, &keeper)
+	delegator := accs[0].GetAddress()
+	amount := sdk.NewInt(1000)
+	before := keeper.AccountKeeper.GetCoins(context, delegator).AmountOf(keeper.StakeDenom(context))
+	assert.Nil(t, keeper.Delegate(context, delegator, validator, amount))
+	validator, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	assert.True(t, validator.StakedTokens.Equal(sdk.NewInt(100000001000)))
+	assert.True(t, validator.GetDelegatedTokens().Equal(amount))
+	assert.True(t, validator.GetDelegatorShares().Equal(sdk.NewDec(1000)))
+	delegation, found := keeper.GetDelegation(context, validator.Address, delegator)
+	assert.True(t, found)
+	assert.True(t, delegation.Shares.Equal(sdk.NewDec(1000)))
+	after := keeper.AccountKeeper.GetCoins(context, delegator).AmountOf(keeper.StakeDenom(context))
+	assert.True(t, before.Sub(amount).Equal(after))
+	assert.Len(t, keeper.GetDelegatorDelegations(context, delegator), 1)
+	// the staked set is updated with the new power
+	assert.Len(t, keeper.GetStakedValidators(context), 1)
+}
+
+func TestKeeper_UndelegateAndUnstakeMatureDelegations(t *testing.T) {
+	context, accs, keeper := createTestInput(t, true)
+	validator := setupDelegationValidator(t, context, &keeper)
+	delegator := accs[0].GetAddress()
+	assert.Nil(t, keeper.Delegate(context, delegator, validator, sdk.NewInt(1000)))
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	_, err := keeper.ValidateUndelegate(context, delegator, validator, sdk.NewInt(1001))
+	assert.Equal(t, types.ErrNotEnoughShares(types.DefaultCodespace), err)
+	_, err = keeper.ValidateUndelegate(context, getRandomValidatorAddress(), validator, sdk.NewInt(1))
+	assert.Equal(t, types.ErrNoDelegation(types.DefaultCodespace), err)
+	shares, err := keeper.ValidateUndelegate(context, delegator, validator, sdk.NewInt(400))
+	assert.Nil(t, err)
+	assert.True(t, shares.Equal(sdk.NewDec(400)))
+	before := keeper.AccountKeeper.GetCoins(context, delegator).AmountOf(keeper.StakeDenom(context))
+	amount := keeper.Undelegate(context, delegator, validator, shares)
+	assert.True(t, amount.Equal(sdk.NewInt(400)))
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	assert.True(t, validator.GetDelegatedTokens().Equal(sdk.NewInt(600)))
+	delegation, _ := keeper.GetDelegation(context, validator.Address, delegator)
+	assert.True(t, delegation.Shares.Equal(sdk.NewDec(600)))
+	uds := keeper.GetDelegatorUnstakingDelegations(context, delegator)
+	assert.Len(t, uds, 1)
+	assert.True(t, uds[0].Amount.Equal(sdk.NewInt(400)))
+	// not mature yet
+	keeper.unstakeAllMatureDelegations(context)
+	assert.Len(t, keeper.GetDelegatorUnstakingDelegations(context, delegator), 1)
+	// mature at the completion time
+	context = context.WithBlockTime(uds[0].CompletionTime)
+	keeper.unstakeAllMatureDelegations(context)
+	assert.Len(t, keeper.GetDelegatorUnstakingDelegations(context, delegator), 0)
+	after := keeper.AccountKeeper.GetCoins(context, delegator).AmountOf(keeper.StakeDenom(context))
+	assert.True(t, before.Add(sdk.NewInt(400)).Equal(after))
+}
+
+func TestKeeper_Redelegate(t *testing.T) {
+	context, accs, keeper := createTestInput(t, true)
+	src := setupDelegationValidator(t, context, &keeper)
+	dst := setupDelegationValidator(t, context, &keeper)
+	delegator := accs[0].GetAddress()
+	assert.Nil(t, keeper.Delegate(context, delegator, src, sdk.NewInt(1000)))
+	src, _ = keeper.GetValidator(context, src.Address)
+	_, err := keeper.ValidateRedelegate(context, delegator, src, src, sdk.NewInt(1000))
+	assert.Equal(t, types.ErrInvalidRedelegation(types.DefaultCodespace), err)
+	shares, err := keeper.ValidateRedelegate(context, delegator, src, dst, sdk.NewInt(1000))
+	assert.Nil(t, err)
+	amount, err := keeper.Redelegate(context, delegator, src, dst, shares)
+	assert.Nil(t, err)
+	assert.True(t, amount.Equal(sdk.NewInt(1000)))
+	_, found := keeper.GetDelegation(context, src.Address, delegator)
+	assert.False(t, found)
+	delegation, found := keeper.GetDelegation(context, dst.Address, delegator)
+	assert.True(t, found)
+	assert.True(t, delegation.Shares.Equal(sdk.NewDec(1000)))
+	src, _ = keeper.GetValidator(context, src.Address)
+	dst, _ = keeper.GetValidator(context, dst.Address)
+	assert.True(t, src.GetDelegatedTokens().IsZero())
+	assert.True(t, src.StakedTokens.Equal(sdk.NewInt(100000000000)))
+	assert.True(t, dst.GetDelegatedTokens().Equal(sdk.NewInt(1000)))
+	// redelegation does not go through the unstaking queue
+	assert.Len(t, keeper.GetDelegatorUnstakingDelegations(context, delegator), 0)
+	// the delegator index follows the moved delegation
+	delegations := keeper.GetDelegatorDelegations(context, delegator)
+	assert.Len(t, delegations, 1)
+	assert.Equal(t, dst.Address, delegations[0].ValidatorAddress)
+}
+
+func TestKeeper_ValidateSetCommission(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := setupDelegationValidator(t, context, &keeper)
+	output := getRandomValidatorAddress()
+	withOutput := validator
+	withOutput.OutputAddress = output
+	tests := []struct {
+		name       string
+		validator  types.Validator
+		commission sdk.Dec
+		signer     sdk.Address
+		err        sdk.Error
+	}{
+		{"validates commission", validator, sdk.NewDecWithPrec(1, 1), validator.Address, nil},
+		{"validates commission signed by the output address", withOutput, sdk.OneDec(), output, nil},
+		{"errors if not signed by the output address", withOutput, sdk.NewDecWithPrec(1, 1), validator.Address, types.ErrUnauthorizedSigner(types.DefaultCodespace)},
+		{"errors on negative commission", validator, sdk.NewDec(-1), validator.Address, types.ErrInvalidCommission(types.DefaultCodespace)},
+		{"errors on commission above one", validator, sdk.NewDec(2), validator.Address, types.ErrInvalidCommission(types.DefaultCodespace)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.err, keeper.ValidateSetCommission(context, test.validator, test.commission, test.signer))
+		})
+	}
+}
+
+func TestKeeper_DistributeRelayReward(t *testing.T) {
+	context, accs, keeper := createTestInput(t, true)
+	validator := setupDelegationValidator(t, context, &keeper)
+	validator.OutputAddress = getRandomValidatorAddress()
+	keeper.SetValidator(context, validator)
+	keeper.SetCommission(context, validator, sdk.NewDecWithPrec(1, 1))
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	// two delegators with half of the stake of the validator each
+	first, second := accs[0].GetAddress(), accs[1].GetAddress()
+	assert.Nil(t, keeper.Delegate(context, first, validator, sdk.NewInt(50000000000)))
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	assert.Nil(t, keeper.Delegate(context, second, validator, sdk.NewInt(50000000000)))
+	firstBefore := keeper.AccountKeeper.GetCoins(context, first).AmountOf(keeper.StakeDenom(context))
+	secondBefore := keeper.AccountKeeper.GetCoins(context, second).AmountOf(keeper.StakeDenom(context))
+	keeper.distributeRelayReward(context, validator.Address, sdk.NewInt(1000))
+	// the delegators earn 500 minus 10% commission
+	firstAfter := keeper.AccountKeeper.GetCoins(context, first).AmountOf(keeper.StakeDenom(context))
+	secondAfter := keeper.AccountKeeper.GetCoins(context, second).AmountOf(keeper.StakeDenom(context))
+	assert.True(t, firstBefore.Add(sdk.NewInt(225)).Equal(firstAfter))
+	assert.True(t, secondBefore.Add(sdk.NewInt(225)).Equal(secondAfter))
+	output := keeper.AccountKeeper.GetCoins(context, validator.OutputAddress).AmountOf(keeper.StakeDenom(context))
+	assert.True(t, output.Equal(sdk.NewInt(550)))
+}
+
+func TestKeeper_SlashDelegatedTokensProportionally(t *testing.T) {
+	context, accs, keeper := createTestInput(t, true)
+	validator := setupDelegationValidator(t, context, &keeper)
+	delegator := accs[0].GetAddress()
+	assert.Nil(t, keeper.Delegate(context, delegator, validator, sdk.NewInt(100000000000)))
+	keeper.simpleSlash(context, validator.Address, sdk.NewInt(1000), types.SlashReasonCustomBurn)
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	assert.True(t, validator.StakedTokens.Equal(sdk.NewInt(199999999000)))
+	assert.True(t, validator.GetDelegatedTokens().Equal(sdk.NewInt(99999999500)))
+	assert.True(t, validator.GetSelfStake().Equal(sdk.NewInt(99999999500)))
+	delegation, _ := keeper.GetDelegation(context, validator.Address, delegator)
+	assert.True(t, validator.TokensFromShares(delegation.Shares).TruncateInt().Equal(sdk.NewInt(99999999500)))
+}
+
+func TestKeeper_SlashUnstakingDelegations(t *testing.T) {
+	context, accs, keeper := createTestInput(t, true)
+	validator := setupDelegationValidator(t, context, &keeper)
+	delegator := accs[0].GetAddress()
+	height := context.BlockHeight()
+	assert.Nil(t, keeper.Delegate(context, delegator, validator, sdk.NewInt(100000000000)))
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	keeper.Undelegate(context, delegator, validator, sdk.NewDec(40000000000))
+	uds := keeper.GetDelegatorUnstakingDelegations(context, delegator)
+	assert.Len(t, uds, 1)
+	assert.Equal(t, height, uds[0].CreationHeight)
+	// an infraction after the undelegation does not reach the undelegated tokens
+	later := context.WithBlockHeight(height + 1)
+	keeper.slash(later, validator.Address, height+1, sdk.TokensToConsensusPower(sdk.NewInt(160000000000)), sdk.NewDecWithPrec(1, 1), types.SlashReasonDoubleSign)
+	uds = keeper.GetDelegatorUnstakingDelegations(context, delegator)
+	assert.True(t, uds[0].Amount.Equal(sdk.NewInt(40000000000)))
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	assert.True(t, validator.StakedTokens.Equal(sdk.NewInt(144000000000)))
+	// an infraction before the undelegation slashes the undelegated tokens first
+	keeper.slash(later, validator.Address, height, sdk.TokensToConsensusPower(sdk.NewInt(200000000000)), sdk.NewDecWithPrec(1, 1), types.SlashReasonDoubleSign)
+	uds = keeper.GetDelegatorUnstakingDelegations(context, delegator)
+	assert.True(t, uds[0].Amount.Equal(sdk.NewInt(36000000000)))
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	assert.True(t, validator.StakedTokens.Equal(sdk.NewInt(128000000000)))
+	// a burn recorded in the block of the undelegation slashes the undelegated tokens with the fraction of the stake burned
+	keeper.simpleSlash(later, validator.Address, sdk.NewInt(12800000000), types.SlashReasonCustomBurn)
+	uds = keeper.GetDelegatorUnstakingDelegations(context, delegator)
+	assert.True(t, uds[0].Amount.Equal(sdk.NewInt(32400000000)))
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	assert.True(t, validator.StakedTokens.Equal(sdk.NewInt(118800000000)))
+	// a burn recorded after the undelegation does not reach the undelegated tokens
+	keeper.simpleSlash(context.WithBlockHeight(height+2), validator.Address, sdk.NewInt(1000), types.SlashReasonCustomBurn)
+	uds = keeper.GetDelegatorUnstakingDelegations(context, delegator)
+	assert.True(t, uds[0].Amount.Equal(sdk.NewInt(32400000000)))
+}
+
+func TestKeeper_PayoutDelegationsOnUnstake(t *testing.T) {
+	context, accs, keeper := createTestInput(t, true)
+	validator := setupDelegationValidator(t, context, &keeper)
+	delegator := accs[0].GetAddress()
+	assert.Nil(t, keeper.Delegate(context, delegator, validator, sdk.NewInt(1000)))
+	before := keeper.AccountKeeper.GetCoins(context, delegator).AmountOf(keeper.StakeDenom(context))
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	keeper.FinishUnstakingValidator(context, validator.UpdateStatus(sdk.Unstaking))
+	after := keeper.AccountKeeper.GetCoins(context, delegator).AmountOf(keeper.StakeDenom(context))
+	assert.True(t, before.Add(sdk.NewInt(1000)).Equal(after))
+	_, found := keeper.GetDelegation(context, validator.Address, delegator)
+	assert.False(t, found)
+	// the validator only receives its own stake
+	coins := keeper.AccountKeeper.GetCoins(context, validator.Address).AmountOf(keeper.StakeDenom(context))
+	assert.True(t, coins.Equal(sdk.NewInt(100000000000)))
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	assert.True(t, validator.StakedTokens.IsZero())
+	assert.True(t, validator.GetDelegatedTokens().IsZero())
+}
+
+func TestKeeper_UnstakeDelegationsOnForceUnstake(t *testing.T) {
+	context, accs, keeper := createTestInput(t, true)
+	validator := setupDelegationValidator(t, context, &keeper)
+	delegator := accs[0].GetAddress()
+	assert.Nil(t, keeper.Delegate(context, delegator, validator, sdk.NewInt(1000)))
+	before := keeper.AccountKeeper.GetCoins(context, delegator).AmountOf(keeper.StakeDenom(context))
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	assert.Nil(t, keeper.ForceValidatorUnstake(context, validator))
+	// the delegated tokens are queued and only the validator's own stake is burned
+	assert.Len(t, keeper.GetValidatorDelegations(context, validator.Address), 0)
+	after := keeper.AccountKeeper.GetCoins(context, delegator).AmountOf(keeper.StakeDenom(context))
+	assert.True(t, before.Equal(after))
+	uds := keeper.GetDelegatorUnstakingDelegations(context, delegator)
+	assert.Len(t, uds, 1)
+	assert.True(t, uds[0].Amount.Equal(sdk.NewInt(1000)))
+	// returned after the unstaking time
+	context = context.WithBlockTime(uds[0].CompletionTime)
+	keeper.unstakeAllMatureDelegations(context)
+	after = keeper.AccountKeeper.GetCoins(context, delegator).AmountOf(keeper.StakeDenom(context))
+	assert.True(t, before.Add(sdk.NewInt(1000)).Equal(after))
+}
+
+func TestKeeper_UndelegateFromJailedValidator(t *testing.T) {
+	context, accs, keeper := createTestInput(t, true)
+	validator := setupDelegationValidator(t, context, &keeper)
+	delegator := accs[0].GetAddress()
+	assert.Nil(t, keeper.Delegate(context, delegator, validator, sdk.NewInt(1000)))
+	dst := setupDelegationValidator(t, context, &keeper)
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	keeper.JailValidator(context, validator.GetAddress())
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	assert.True(t, validator.IsJailed())
+	// the jailed validator is not put back in the staked set
+	shares, err := keeper.ValidateRedelegate(context, delegator, validator, dst, sdk.NewInt(500))
+	assert.Nil(t, err)
+	_, err = keeper.Redelegate(context, delegator, validator, dst, shares)
+	assert.Nil(t, err)
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	shares, err = keeper.ValidateUndelegate(context, delegator, validator, sdk.NewInt(500))
+	assert.Nil(t, err)
+	keeper.Undelegate(context, delegator, validator, shares)
+	for _, v := range keeper.GetStakedValidators(context) {
+		assert.False(t, v.GetAddress().Equals(validator.Address))
+	}
+	assert.NotPanics(t, func() { keeper.UpdateTendermintValidators(context) })
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// set an earning and its height index in the store
+func (k Keeper) SetEarning(ctx sdk.Ctx, earning types.Earning) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyForEarning(earning.Address, earning.Height, earning.Type), types.MustMarshalEarning(k.cdc, earning))
+	store.Set(types.KeyForEarningByHeight(earning.Height, earning.Address, earning.Type), []byte{})
+}
+
+// return the retained earnings of a validator, sorted by height
+func (k Keeper) GetEarnings(ctx sdk.Ctx, address sdk.Address) (earnings []types.Earning) {
+	earnings = make([]types.Earning, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyForEarnings(address))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		earnings = append(earnings, types.MustUnmarshalEarning(k.cdc, iterator.Value()))
+	}
+	return earnings
+}
+
+// return the earnings history of a validator with the relay reward and the burns pending for the next block
+func (k Keeper) GetValidatorEarnings(ctx sdk.Ctx, address sdk.Address) types.ValidatorEarnings {
+	award, _ := k.getValidatorAward(ctx, address)
+	burn, _ := k.getValidatorBurn(ctx, address)
+	challengeBurn, _ := k.getValidatorChallengeBurn(ctx, address)
+	return types.ValidatorEarnings{
+		Address:            address,
+		PendingRelayReward: k.NodeCutOfReward(ctx).Mul(award).Quo(sdk.NewInt(100)), // truncate
+		PendingBurn:        burn.Add(challengeBurn),
+		Earnings:           k.GetEarnings(ctx, address),
+	}
+}
+
+// record the tokens minted to a validator at the current height
+func (k Keeper) recordEarning(ctx sdk.Ctx, address sdk.Address, earningType string, amount sdk.Int) {
+	if k.EarningsRetention(ctx) == 0 || !amount.IsPositive() {
+		return
+	}
+	k.SetEarning(ctx, types.Earning{
+		Address: address,
+		Height:  ctx.BlockHeight(),
+		Type:    earningType,
+		Amount:  amount,
+	})
+}
+
+// delete the earnings older than the retention, called on begin blocker
+func (k Keeper) pruneEarnings(ctx sdk.Ctx) {
+	store := ctx.KVStore(k.storeKey)
+	// the earnings at or below the cutoff height are past the retention
+	cutoff := ctx.BlockHeight() - k.EarningsRetention(ctx)
+	if cutoff < 0 {
+		return
+	}
+	iterator := store.Iterator(types.EarningByHeightKey, sdk.PrefixEndBytes(types.KeyForEarningsByHeight(cutoff)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// the index key is prefix | height | address | type
+		key := iterator.Key()
+		height := int64(binary.BigEndian.Uint64(key[1:9]))
+		address := sdk.Address(key[9 : 9+sdk.AddrLen])
+		earningType := string(key[9+sdk.AddrLen:])
+		store.Delete(types.KeyForEarning(address, height, earningType))
+		store.Delete(key)
+	}
+}
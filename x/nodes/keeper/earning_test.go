@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestKeeper_ValidatorEarnings(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	keeper.SetValidator(context, validator)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	// the relay reward and the burns are pending until the next block
+	keeper.RewardForRelays(context, sdk.NewInt(10), validator.Address)
+	keeper.BurnValidator(context, validator.Address, sdk.NewInt(100))
+	keeper.BurnForChallenge(context, sdk.NewInt(1), validator.Address)
+	award := keeper.RelaysToTokensMultiplier(context).MulRaw(10)
+	relayReward := keeper.NodeCutOfReward(context).Mul(award).Quo(sdk.NewInt(100))
+	earnings := keeper.GetValidatorEarnings(context, validator.Address)
+	assert.True(t, earnings.PendingRelayReward.Equal(relayReward))
+	assert.True(t, earnings.PendingBurn.Equal(keeper.RelaysToTokensMultiplier(context).AddRaw(100)))
+	assert.Len(t, earnings.Earnings, 0)
+	// minted rewards are recorded in the earnings history
+	context = context.WithBlockHeight(2)
+	keeper.mintNodeRelayRewards(context)
+	keeper.burnValidators(context)
+	keeper.recordEarning(context, validator.Address, types.EarningTypeProposer, sdk.NewInt(5))
+	earnings = keeper.GetValidatorEarnings(context, validator.Address)
+	assert.True(t, earnings.PendingRelayReward.IsZero())
+	assert.True(t, earnings.PendingBurn.IsZero())
+	assert.Len(t, earnings.Earnings, 2)
+	assert.Equal(t, types.Earning{Address: validator.Address, Height: 2, Type: types.EarningTypeProposer, Amount: sdk.NewInt(5)}, earnings.Earnings[0])
+	assert.Equal(t, types.Earning{Address: validator.Address, Height: 2, Type: types.EarningTypeRelay, Amount: relayReward}, earnings.Earnings[1])
+}
+
+func TestKeeper_PruneEarnings(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	retention := keeper.EarningsRetention(context)
+	address := getRandomValidatorAddress()
+	other := getRandomValidatorAddress()
+	keeper.recordEarning(context.WithBlockHeight(1), address, types.EarningTypeRelay, sdk.NewInt(1))
+	keeper.recordEarning(context.WithBlockHeight(1), other, types.EarningTypeProposer, sdk.NewInt(1))
+	keeper.recordEarning(context.WithBlockHeight(2), address, types.EarningTypeRelay, sdk.NewInt(2))
+	// nothing is pruned within the retention
+	keeper.pruneEarnings(context.WithBlockHeight(retention))
+	assert.Len(t, keeper.GetEarnings(context, address), 2)
+	assert.Len(t, keeper.GetEarnings(context, other), 1)
+	// the earnings at the height past the retention are pruned
+	keeper.pruneEarnings(context.WithBlockHeight(retention + 1))
+	earnings := keeper.GetEarnings(context, address)
+	assert.Len(t, earnings, 1)
+	assert.Equal(t, int64(2), earnings[0].Height)
+	assert.Len(t, keeper.GetEarnings(context, other), 0)
+	// no earnings are recorded without retention
+	params := keeper.GetParams(context)
+	params.EarningsRetention = 0
+	keeper.SetParams(context, params)
+	keeper.recordEarning(context.WithBlockHeight(3), address, types.EarningTypeRelay, sdk.NewInt(3))
+	assert.Len(t, keeper.GetEarnings(context, address), 1)
+}
@@ -86,6 +86,24 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Ctx) (res sdk.Dec) {
 	return
 }
 
+// DowntimeJailEscalation
+func (k Keeper) DowntimeJailEscalation(ctx sdk.Ctx) (res sdk.Dec) {
+	k.Paramstore.Get(ctx, types.KeyDowntimeJailEscalation, &res)
+	return
+}
+
+// DowntimeJailDecayPeriod
+func (k Keeper) DowntimeJailDecayPeriod(ctx sdk.Ctx) (res int64) {
+	k.Paramstore.Get(ctx, types.KeyDowntimeJailDecayPeriod, &res)
+	return
+}
+
+// EarningsRetention
+func (k Keeper) EarningsRetention(ctx sdk.Ctx) (res int64) {
+	k.Paramstore.Get(ctx, types.KeyEarningsRetention, &res)
+	return
+}
+
 func (k Keeper) RelaysToTokensMultiplier(ctx sdk.Ctx) sdk.Int {
 	return sdk.NewInt(1000) // todo parameterize
 }
@@ -122,6 +140,9 @@ func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 		DowntimeJailDuration:    k.DowntimeJailDuration(ctx),
 		SlashFractionDoubleSign: k.SlashFractionDoubleSign(ctx),
 		SlashFractionDowntime:   k.SlashFractionDowntime(ctx),
+		DowntimeJailEscalation:  k.DowntimeJailEscalation(ctx),
+		DowntimeJailDecayPeriod: k.DowntimeJailDecayPeriod(ctx),
+		EarningsRetention:       k.EarningsRetention(ctx),
 	}
 }
 
@@ -129,3 +150,13 @@ func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 func (k Keeper) SetParams(ctx sdk.Ctx, params types.Params) {
 	k.Paramstore.SetParamSet(ctx, &params)
 }
+
+// backfill the default of every param missing from the world state, for the params added after the chain started
+func (k Keeper) BackfillParams(ctx sdk.Ctx) {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !k.Paramstore.Has(ctx, pair.Key) {
+			k.Paramstore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
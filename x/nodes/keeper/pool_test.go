@@ -116,6 +116,17 @@ func TestCoinsFromStakedToUnstaked(t *testing.T) {
 	}
 }
 
+func TestCoinsFromStakedToUnstakedOutputAddress(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := types.Validator{Address: getRandomValidatorAddress(), OutputAddress: getRandomValidatorAddress(), StakedTokens: sdk.NewInt(10)}
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	keeper.coinsFromStakedToUnstaked(context, validator)
+	// the unstaked tokens are sent to the output address and not to the validator
+	coins := keeper.AccountKeeper.GetCoins(context, validator.OutputAddress)
+	assert.True(t, sdk.NewCoins(sdk.NewCoin(keeper.StakeDenom(context), validator.StakedTokens)).IsEqual(coins), "coins should match")
+	assert.True(t, keeper.AccountKeeper.GetCoins(context, validator.Address).IsZero())
+}
+
 func TestBurnStakedTokens(t *testing.T) {
 	validator := getStakedValidator()
 	validatorAddress := validator.Address
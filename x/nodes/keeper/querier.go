@@ -37,6 +37,16 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryAccount(ctx, req, k)
 		case types.QueryParameters:
 			return queryParameters(ctx, k)
+		case types.QueryDelegations:
+			return queryDelegations(ctx, req, k)
+		case types.QueryUnstakingDelegations:
+			return queryUnstakingDelegations(ctx, req, k)
+		case types.QuerySlashRecords:
+			return querySlashRecords(ctx, req, k)
+		case types.QueryEarnings:
+			return queryEarnings(ctx, req, k)
+		case types.QueryUnstakingSchedule:
+			return queryUnstakingSchedule(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -49,7 +59,10 @@ func queryValidators(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.
 	if err != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
-	validators := k.GetAllValidators(ctx)
+	if err := params.Validate(); err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+	validators := params.Filter(k.GetAllValidators(ctx))
 	start, end := util.Paginate(len(validators), params.Page, params.Limit, int(k.GetParams(ctx).MaxValidators))
 	if start < 0 || end < 0 {
 		validators = []types.Validator{}
@@ -249,3 +262,73 @@ func querySigningInfos(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sd
 	}
 	return res, nil
 }
+
+func queryDelegations(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegatorParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	delegations := k.GetDelegatorDelegations(ctx, params.Address)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, delegations)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryUnstakingDelegations(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegatorParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	uds := k.GetDelegatorUnstakingDelegations(ctx, params.Address)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, uds)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func querySlashRecords(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	records := k.GetSlashRecords(ctx, params.Address)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, records)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryEarnings(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	earnings := k.GetValidatorEarnings(ctx, params.Address)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, earnings)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryUnstakingSchedule(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	entries := k.GetUnstakingSchedule(ctx, params.Address)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, entries)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
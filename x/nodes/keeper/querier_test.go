@@ -295,6 +295,31 @@ func Test_querySigningInfo(t *testing.T) {
 	}
 }
 
+func Test_querySlashRecords(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	record := types.SlashRecord{Address: validator.Address, Height: 1, Reason: types.SlashReasonDowntime, Amount: sdk.NewInt(10), StakedTokens: sdk.NewInt(90)}
+	keeper.SetSlashRecord(context, record)
+	jsondata, _ := amino.MarshalJSON(types.NewQueryValidatorParams(validator.Address))
+	jsonresponse, _ := amino.MarshalJSONIndent([]types.SlashRecord{record}, "", "  ")
+	got, err := querySlashRecords(context, abci.RequestQuery{Data: jsondata, Path: types.QuerySlashRecords}, keeper)
+	assert.Nil(t, err)
+	assert.Equal(t, jsonresponse, got)
+}
+
+func Test_queryEarnings(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	keeper.SetValidator(context, validator)
+	earning := types.Earning{Address: validator.Address, Height: 1, Type: types.EarningTypeRelay, Amount: sdk.NewInt(10)}
+	keeper.SetEarning(context, earning)
+	jsondata, _ := amino.MarshalJSON(types.NewQueryValidatorParams(validator.Address))
+	jsonresponse, _ := amino.MarshalJSONIndent(keeper.GetValidatorEarnings(context, validator.Address), "", "  ")
+	got, err := queryEarnings(context, abci.RequestQuery{Data: jsondata, Path: types.QueryEarnings}, keeper)
+	assert.Nil(t, err)
+	assert.Equal(t, jsonresponse, got)
+}
+
 func Test_querySigningInfos(t *testing.T) {
 	type args struct {
 		ctx sdk.Context
@@ -11,6 +11,8 @@ import (
 
 // award coins to an address (will be called at the beginning of the next block)
 func (k Keeper) RewardForRelays(ctx sdk.Ctx, relays sdk.Int, address sdk.Address) {
+	// relays serviced with a rotated consensus key are awarded to its validator
+	address = k.ValidatorAddressFromConsensusAddress(ctx, address)
 	award, _ := k.getValidatorAward(ctx, address)
 	coins := k.RelaysToTokensMultiplier(ctx).Mul(relays)
 	k.setValidatorAward(ctx, award.Add(coins), address)
@@ -57,14 +59,15 @@ func (k Keeper) blockReward(ctx sdk.Ctx, previousProposer sdk.Address) {
 		if err != nil {
 			panic(err)
 		}
-		// send to validator
-		if err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, proposerValidator.GetAddress(), propRewardCoins); err != nil {
+		// send to the output address of the validator
+		if err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, proposerValidator.GetOutputAddress(), propRewardCoins); err != nil {
 			panic(err)
 		}
 		// send to rest dao
 		if err := k.AccountKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, govTypes.DAOAccountName, daoRewardCoins); err != nil {
 			panic(err)
 		}
+		k.recordEarning(ctx, proposerValidator.Address, types.EarningTypeProposer, proposerReward)
 		logger.Info(fmt.Sprintf("minted %s to block proposer: %s", propRewardCoins.String(), proposerValidator.GetAddress().String()))
 		logger.Info(fmt.Sprintf("minted %s to DAO", daoRewardCoins.String()))
 		ctx.EventManager().EmitEvent(
@@ -137,7 +140,9 @@ func (k Keeper) mintNodeRelayRewards(ctx sdk.Ctx) {
 		address := sdk.Address(types.AddressFromKey(iterator.Key()))
 		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &amount)
 		amount = k.NodeCutOfReward(ctx).Mul(amount).Quo(sdk.NewInt(100)) // truncate
-		k.mint(ctx, amount, address)
+		// the reward is shared with the delegators and goes to the output address of the validator if one is set
+		validatorReward := k.distributeRelayReward(ctx, address, amount)
+		k.recordEarning(ctx, address, types.EarningTypeRelay, validatorReward)
 		// remove from the award store
 		store.Delete(iterator.Key())
 		ctx.Logger().Info("Relay reward of " + amount.String() + " minted to" + address.String())
@@ -203,6 +203,21 @@ func TestMintValidatorAwards(t *testing.T) {
 	}
 }
 
+func TestMintValidatorAwardsToOutputAddress(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	validator.OutputAddress = getRandomValidatorAddress()
+	keeper.SetValidator(context, validator)
+	amount := sdk.NewInt(90)
+	keeper.setValidatorAward(context, amount, validator.Address)
+	keeper.mintNodeRelayRewards(context)
+	expected := keeper.NodeCutOfReward(context).Mul(amount).Quo(sdk.NewInt(100))
+	// the reward is minted to the output address and not to the validator
+	coins := keeper.AccountKeeper.GetCoins(context, validator.OutputAddress)
+	assert.True(t, sdk.NewCoins(sdk.NewCoin(keeper.StakeDenom(context), expected)).IsEqual(coins), "coins should match")
+	assert.True(t, keeper.AccountKeeper.GetCoins(context, validator.Address).IsZero())
+}
+
 func TestKeeper_GetTotalCustomValidatorAwards(t *testing.T) {
 	type fields struct {
 		keeper Keeper
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/pokt-network/pocket-core/x/nodes/exported"
@@ -21,28 +22,38 @@ func (k Keeper) BurnValidator(ctx sdk.Ctx, address sdk.Address, amount sdk.Int)
 
 func (k Keeper) BurnForChallenge(ctx sdk.Ctx, challenges sdk.Int, address sdk.Address) {
 	coins := k.RelaysToTokensMultiplier(ctx).Mul(challenges)
-	val, found := k.GetValidator(ctx, address)
+	val, found := k.GetValidator(ctx, k.ValidatorAddressFromConsensusAddress(ctx, address))
 	if !found {
 		ctx.Logger().Error("validator trying to burn for challenges, not found: possibly force unstaked?")
 		return
 	}
-	k.BurnValidator(ctx, val.Address, coins)
+	curBurn, _ := k.getValidatorChallengeBurn(ctx, val.Address)
+	k.setValidatorChallengeBurn(ctx, curBurn.Add(coins), val.Address)
+	ctx.Logger().Info("Challenge burn set for " + val.Address.String() + " with a severity of " + coins.String())
 }
 
-func (k Keeper) simpleSlash(ctx sdk.Ctx, addr sdk.Address, amount sdk.Int) {
+func (k Keeper) simpleSlash(ctx sdk.Ctx, addr sdk.Address, amount sdk.Int, reason string) {
 	// error check slash
 	validator := k.validateSimpleSlash(ctx, addr, amount)
 	if validator.Address == nil {
 		return // invalid simple slash
 	}
+	// the burn was recorded in the previous block, the tokens undelegated since then were part of the stake and are
+	// slashed first with the fraction of the stake burned
+	unstakingBurned := sdk.ZeroInt()
+	if validator.StakedTokens.IsPositive() {
+		slashFactor := sdk.MinDec(amount.ToDec().Quo(validator.StakedTokens.ToDec()), sdk.OneDec())
+		unstakingBurned = k.slashUnstakingDelegations(ctx, validator.Address, ctx.BlockHeight()-1, slashFactor)
+	}
 	// cannot decrease balance below zero
-	tokensToBurn := sdk.MinInt(amount, validator.StakedTokens)
+	tokensToBurn := sdk.MinInt(amount.Sub(unstakingBurned), validator.StakedTokens)
 	tokensToBurn = sdk.MaxInt(tokensToBurn, sdk.ZeroInt()) // defensive.
 	validator = k.removeValidatorTokens(ctx, validator, tokensToBurn)
 	err := k.burnStakedTokens(ctx, tokensToBurn)
 	if err != nil {
 		panic(err)
 	}
+	k.recordSlash(ctx, validator, reason, tokensToBurn.Add(unstakingBurned))
 	// if falls below minimum force burn all of the stake
 	if validator.GetTokens().LT(sdk.NewInt(k.MinimumStake(ctx))) {
 		err := k.ForceValidatorUnstake(ctx, validator)
@@ -76,7 +87,7 @@ func (k Keeper) validateSimpleSlash(ctx sdk.Ctx, addr sdk.Address, amount sdk.In
 
 // slash a validator for an infraction committed at a known height
 // Find the contributing stake at that height and burn the specified slashFactor
-func (k Keeper) slash(ctx sdk.Ctx, consAddr sdk.Address, infractionHeight, power int64, slashFactor sdk.Dec) {
+func (k Keeper) slash(ctx sdk.Ctx, consAddr sdk.Address, infractionHeight, power int64, slashFactor sdk.Dec, reason string) {
 	// error check slash
 	validator := k.validateSlash(ctx, consAddr, infractionHeight, power, slashFactor)
 	if validator.Address == nil {
@@ -86,6 +97,10 @@ func (k Keeper) slash(ctx sdk.Ctx, consAddr sdk.Address, infractionHeight, power
 	// Amount of slashing = slash slashFactor * power at time of infraction
 	amount := sdk.TokensFromConsensusPower(power)
 	slashAmount := amount.ToDec().Mul(slashFactor).TruncateInt()
+	// the tokens partially unstaked or undelegated since the infraction were part of the power, they are slashed first
+	unstakingBurned := k.slashPartialUnstakes(ctx, validator.Address, infractionHeight, slashFactor)
+	unstakingBurned = unstakingBurned.Add(k.slashUnstakingDelegations(ctx, validator.Address, infractionHeight, slashFactor))
+	slashAmount = slashAmount.Sub(unstakingBurned)
 	// cannot decrease balance below zero
 	tokensToBurn := sdk.MinInt(slashAmount, validator.StakedTokens)
 	tokensToBurn = sdk.MaxInt(tokensToBurn, sdk.ZeroInt()) // defensive.
@@ -96,6 +111,7 @@ func (k Keeper) slash(ctx sdk.Ctx, consAddr sdk.Address, infractionHeight, power
 	if err != nil {
 		panic(err)
 	}
+	k.recordSlash(ctx, validator, reason, tokensToBurn.Add(unstakingBurned))
 	// if falls below minimum force burn all of the stake
 	if validator.GetTokens().LT(sdk.NewInt(k.MinimumStake(ctx))) {
 		err := k.ForceValidatorUnstake(ctx, validator)
@@ -154,12 +170,14 @@ func (k Keeper) handleDoubleSign(ctx sdk.Ctx, addr crypto.Address, infractionHei
 			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
 		),
 	)
-	k.slash(ctx, address, distributionHeight, power, fraction)
+	k.slash(ctx, address, distributionHeight, power, fraction, types.SlashReasonDoubleSign)
 	// todo fix once tendermint is patched
 }
 
 func (k Keeper) validateDoubleSign(ctx sdk.Ctx, addr crypto.Address, infractionHeight int64, timestamp time.Time) (address sdk.Address, signInfo types.ValidatorSigningInfo, validator exported.ValidatorI, err sdk.Error) {
 	logger := k.Logger(ctx)
+	// evidence is signed with the consensus key, which may have been rotated
+	addr = crypto.Address(k.ValidatorAddressFromConsensusAddress(ctx, sdk.Address(addr)))
 	val, found := k.GetValidator(ctx, sdk.Address(addr))
 	if !found || val.IsUnstaked() {
 		// Ignore evidence that cannot be handled.
@@ -196,7 +214,7 @@ func (k Keeper) validateDoubleSign(ctx sdk.Ctx, addr crypto.Address, infractionH
 func (k Keeper) handleValidatorSignature(ctx sdk.Ctx, address crypto.Address, power int64, signed bool) {
 	logger := k.Logger(ctx)
 	height := ctx.BlockHeight()
-	addr := sdk.Address(address)
+	addr := k.ValidatorAddressFromConsensusAddress(ctx, sdk.Address(address))
 	val, found := k.GetValidator(ctx, addr)
 	if !found {
 		panic(fmt.Sprintf("Validator consensus-address %s not found", addr))
@@ -254,6 +272,11 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Ctx, address crypto.Address, po
 			// Note that this *can* result in a negative "distributionHeight" up to -ValidatorUpdateDelay-1,
 			// i.e. at the end of the pre-genesis block (none) = at the beginning of the genesis block.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
+			// repeat offenders within the decay period are jailed longer and slashed harder
+			if height-signInfo.LastJailHeight > k.DowntimeJailDecayPeriod(ctx) {
+				signInfo.JailCount = 0
+			}
+			escalation := k.downtimeEscalation(ctx, signInfo.JailCount)
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -263,9 +286,11 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Ctx, address crypto.Address, po
 					sdk.NewAttribute(types.AttributeKeyJailed, addr.String()),
 				),
 			)
-			k.slash(ctx, addr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			k.slash(ctx, addr, distributionHeight, power, sdk.MinDec(k.SlashFractionDowntime(ctx).Mul(escalation), sdk.OneDec()), types.SlashReasonDowntime)
 			k.JailValidator(ctx, addr)
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.escalatedDowntimeJailDuration(ctx, escalation))
+			signInfo.JailCount++
+			signInfo.LastJailHeight = height
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon restaking.
 			signInfo.MissedBlocksCounter = 0
 			signInfo.IndexOffset = 0
@@ -281,6 +306,30 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Ctx, address crypto.Address, po
 	k.SetValidatorSigningInfo(ctx, addr, signInfo)
 }
 
+// returns the multiplier of the downtime jail duration and slash fraction of a validator jailed jailCount times within the decay period
+func (k Keeper) downtimeEscalation(ctx sdk.Ctx, jailCount int64) sdk.Dec {
+	multiplier := k.DowntimeJailEscalation(ctx)
+	escalation := sdk.OneDec()
+	maxEscalation := sdk.NewDec(math.MaxInt64)
+	for i := int64(0); i < jailCount; i++ {
+		escalation = escalation.Mul(multiplier)
+		// past this point the jail duration is already the longest possible
+		if escalation.GT(maxEscalation) {
+			return maxEscalation
+		}
+	}
+	return escalation
+}
+
+// returns the downtime jail duration multiplied by the escalation, capped at the longest duration
+func (k Keeper) escalatedDowntimeJailDuration(ctx sdk.Ctx, escalation sdk.Dec) time.Duration {
+	duration := sdk.NewDec(int64(k.DowntimeJailDuration(ctx))).Mul(escalation)
+	if duration.GT(sdk.NewDec(math.MaxInt64)) {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(duration.TruncateInt64())
+}
+
 func (k Keeper) getBurnFromSeverity(ctx sdk.Ctx, address sdk.Address, severityPercentage sdk.Dec) sdk.Int {
 	val := k.mustGetValidator(ctx, address)
 	amount := sdk.TokensFromConsensusPower(val.ConsensusPower())
@@ -290,14 +339,19 @@ func (k Keeper) getBurnFromSeverity(ctx sdk.Ctx, address sdk.Address, severityPe
 
 // called on begin blocker
 func (k Keeper) burnValidators(ctx sdk.Ctx) {
+	k.burnValidatorsWithPrefix(ctx, types.BurnValidatorKey, types.SlashReasonCustomBurn)
+	k.burnValidatorsWithPrefix(ctx, types.ChallengeBurnValidatorKey, types.SlashReasonChallenge)
+}
+
+func (k Keeper) burnValidatorsWithPrefix(ctx sdk.Ctx, prefix []byte, reason string) {
 	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, types.BurnValidatorKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		severity := sdk.ZeroInt()
 		address := sdk.Address(types.AddressFromKey(iterator.Key()))
 		amino.MustUnmarshalBinaryBare(iterator.Value(), &severity)
-		k.simpleSlash(ctx, address, severity)
+		k.simpleSlash(ctx, address, severity, reason)
 		// remove from the burn store
 		store.Delete(iterator.Key())
 	}
@@ -327,3 +381,23 @@ func (k Keeper) deleteValidatorBurn(ctx sdk.Ctx, address sdk.Address) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.KeyForValidatorBurn(address))
 }
+
+// store functions used to keep track of a validator burn for challenges
+func (k Keeper) setValidatorChallengeBurn(ctx sdk.Ctx, amount sdk.Int, address sdk.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyForValidatorChallengeBurn(address), amino.MustMarshalBinaryBare(amount))
+}
+
+func (k Keeper) getValidatorChallengeBurn(ctx sdk.Ctx, address sdk.Address) (coins sdk.Int, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.KeyForValidatorChallengeBurn(address))
+	if value == nil {
+		return sdk.ZeroInt(), false
+	}
+	found = true
+	err := k.cdc.UnmarshalBinaryBare(value, &coins)
+	if err != nil {
+		coins = sdk.ZeroInt()
+	}
+	return
+}
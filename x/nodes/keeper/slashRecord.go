@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// set a slash record in the store
+func (k Keeper) SetSlashRecord(ctx sdk.Ctx, record types.SlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyForSlashRecord(record.Address, record.Height, record.Sequence, record.Reason), types.MustMarshalSlashRecord(k.cdc, record))
+}
+
+// return the next sequence of the slash records of a validator at a height
+func (k Keeper) nextSlashRecordSequence(ctx sdk.Ctx, address sdk.Address, height int64) (sequence int64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyForSlashRecordsAtHeight(address, height))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		sequence++
+	}
+	return sequence
+}
+
+// return the slash records of a validator, sorted by height and sequence
+func (k Keeper) GetSlashRecords(ctx sdk.Ctx, address sdk.Address) (records []types.SlashRecord) {
+	records = make([]types.SlashRecord, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyForSlashRecords(address))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		records = append(records, types.MustUnmarshalSlashRecord(k.cdc, iterator.Value()))
+	}
+	return records
+}
+
+// record the tokens burned from the stake of a validator at the current height
+func (k Keeper) recordSlash(ctx sdk.Ctx, validator types.Validator, reason string, amount sdk.Int) {
+	k.SetSlashRecord(ctx, types.SlashRecord{
+		Address:      validator.Address,
+		Height:       ctx.BlockHeight(),
+		Sequence:     k.nextSlashRecordSequence(ctx, validator.Address, ctx.BlockHeight()),
+		Reason:       reason,
+		Amount:       amount,
+		StakedTokens: validator.StakedTokens,
+	})
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestKeeper_SlashRecords(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	keeper.SetValidator(context, validator)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	assert.Len(t, keeper.GetSlashRecords(context, validator.Address), 0)
+	// the custom burn and the challenge burn are recorded with their reasons
+	keeper.BurnValidator(context, validator.Address, sdk.NewInt(1000))
+	keeper.BurnForChallenge(context, sdk.NewInt(2), validator.Address)
+	keeper.burnValidators(context)
+	challengeBurn := keeper.RelaysToTokensMultiplier(context).MulRaw(2)
+	records := keeper.GetSlashRecords(context, validator.Address)
+	assert.Len(t, records, 2)
+	// the custom burns are executed before the challenge burns and the records of a height keep that order
+	assert.Equal(t, types.SlashReasonCustomBurn, records[0].Reason)
+	assert.True(t, records[0].Amount.Equal(sdk.NewInt(1000)))
+	assert.Equal(t, int64(0), records[0].Sequence)
+	assert.Equal(t, types.SlashReasonChallenge, records[1].Reason)
+	assert.True(t, records[1].Amount.Equal(challengeBurn))
+	assert.Equal(t, int64(1), records[1].Sequence)
+	updated, _ := keeper.GetValidator(context, validator.Address)
+	assert.True(t, records[0].StakedTokens.Equal(validator.StakedTokens.SubRaw(1000)))
+	assert.True(t, records[1].StakedTokens.Equal(updated.StakedTokens))
+	assert.True(t, updated.StakedTokens.Equal(validator.StakedTokens.Sub(challengeBurn).SubRaw(1000)))
+	// slashes at a later height are sorted after
+	context = context.WithBlockHeight(context.BlockHeight() + 1)
+	keeper.slash(context, validator.Address, context.BlockHeight(), updated.ConsensusPower(), keeper.SlashFractionDoubleSign(context), types.SlashReasonDoubleSign)
+	records = keeper.GetSlashRecords(context, validator.Address)
+	assert.Len(t, records, 3)
+	assert.Equal(t, types.SlashReasonDoubleSign, records[2].Reason)
+	assert.Equal(t, context.BlockHeight(), records[2].Height)
+	// the slashes with the same reason at the same height are all kept
+	keeper.simpleSlash(context, validator.Address, sdk.NewInt(10), types.SlashReasonCustomBurn)
+	keeper.simpleSlash(context, validator.Address, sdk.NewInt(20), types.SlashReasonCustomBurn)
+	records = keeper.GetSlashRecords(context, validator.Address)
+	assert.Len(t, records, 5)
+	assert.True(t, records[3].Amount.Equal(sdk.NewInt(10)))
+	assert.True(t, records[4].Amount.Equal(sdk.NewInt(20)))
+	assert.Equal(t, int64(2), records[4].Sequence)
+	// the records of other validators are not returned
+	assert.Len(t, keeper.GetSlashRecords(context, getRandomValidatorAddress()), 0)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 	"testing"
 	"time"
@@ -570,7 +571,7 @@ func TestSlash(t *testing.T) {
 				fraction = keeper.SlashFractionDoubleSign(context)
 			}
 
-			keeper.slash(context, sdk.Address(cryptoAddr), infractionHeight, test.args.power, fraction)
+			keeper.slash(context, sdk.Address(cryptoAddr), infractionHeight, test.args.power, fraction, types.SlashReasonDoubleSign)
 			validator, found := keeper.GetValidator(context, sdk.Address(cryptoAddr))
 			if !found {
 				t.Fail()
@@ -707,3 +708,59 @@ func TestKeeper_BurnValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleValidatorSignatureEscalation(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	keeper.SetValidator(context, validator)
+	keeper.SetStakedValidator(context, validator)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	cryptoAddr := validator.GetPublicKey().Address()
+	keeper.SetValidatorSigningInfo(context, validator.Address, types.ValidatorSigningInfo{
+		Address:     validator.Address,
+		StartHeight: 0,
+		JailedUntil: time.Unix(0, 0),
+	})
+	maxMissed := keeper.SignedBlocksWindow(context) - keeper.MinSignedPerWindow(context)
+	// jail the validator for downtime at the height
+	jail := func(height int64) (types.ValidatorSigningInfo, sdk.Int) {
+		ctx := context.WithBlockHeight(height)
+		before, _ := keeper.GetValidator(ctx, validator.Address)
+		signInfo, _ := keeper.GetValidatorSigningInfo(ctx, validator.Address)
+		signInfo.MissedBlocksCounter = maxMissed + 1
+		keeper.SetValidatorSigningInfo(ctx, validator.Address, signInfo)
+		keeper.handleValidatorSignature(ctx, cryptoAddr, before.ConsensusPower(), false)
+		after, _ := keeper.GetValidator(ctx, validator.Address)
+		assert.True(t, after.IsJailed())
+		keeper.UnjailValidator(ctx, validator.Address)
+		signInfo, _ = keeper.GetValidatorSigningInfo(ctx, validator.Address)
+		return signInfo, before.StakedTokens.Sub(after.StakedTokens)
+	}
+	jailDuration := keeper.DowntimeJailDuration(context)
+	height := keeper.SignedBlocksWindow(context) + 1
+	signInfo, slashed := jail(height)
+	assert.Equal(t, int64(1), signInfo.JailCount)
+	assert.Equal(t, height, signInfo.LastJailHeight)
+	assert.Equal(t, context.BlockHeader().Time.Add(jailDuration), signInfo.JailedUntil)
+	// jailed again within the decay period: the jail duration and slash fraction are escalated
+	height += 10
+	signInfo, escalatedSlash := jail(height)
+	assert.Equal(t, int64(2), signInfo.JailCount)
+	assert.Equal(t, height, signInfo.LastJailHeight)
+	assert.Equal(t, context.BlockHeader().Time.Add(2*jailDuration), signInfo.JailedUntil)
+	assert.True(t, escalatedSlash.GT(slashed))
+	// jailed after the decay period: the jail count starts over
+	height += keeper.DowntimeJailDecayPeriod(context) + 1
+	signInfo, _ = jail(height)
+	assert.Equal(t, int64(1), signInfo.JailCount)
+	assert.Equal(t, context.BlockHeader().Time.Add(jailDuration), signInfo.JailedUntil)
+}
+
+func TestKeeper_downtimeEscalation(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	assert.True(t, keeper.downtimeEscalation(context, 0).Equal(sdk.OneDec()))
+	assert.True(t, keeper.downtimeEscalation(context, 3).Equal(sdk.NewDec(8)))
+	// capped so the jail duration doesn't overflow
+	assert.True(t, keeper.downtimeEscalation(context, 1000).Equal(sdk.NewDec(math.MaxInt64)))
+	assert.Equal(t, time.Duration(math.MaxInt64), keeper.escalatedDowntimeJailDuration(context, keeper.downtimeEscalation(context, 1000)))
+}
@@ -10,18 +10,25 @@ import (
 func (k Keeper) SetStakedValidator(ctx sdk.Ctx, validator types.Validator) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.KeyForValidatorInStakingSet(validator), validator.Address)
+	for _, chain := range validator.Chains {
+		store.Set(types.KeyForValidatorByChain(chain, validator.Address), validator.Address)
+	}
 }
 
 // delete validator from staked set
 func (k Keeper) deleteValidatorFromStakingSet(ctx sdk.Ctx, validator types.Validator) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.KeyForValidatorInStakingSet(validator))
+	for _, chain := range validator.Chains {
+		store.Delete(types.KeyForValidatorByChain(chain, validator.Address))
+	}
 }
 
 // Update the staked tokens of an existing validator, update the validators power index key
+// NOTE the delegators bear their proportional share of the removed tokens
 func (k Keeper) removeValidatorTokens(ctx sdk.Ctx, v types.Validator, tokensToRemove sdk.Int) types.Validator {
 	k.deleteValidatorFromStakingSet(ctx, v)
-	v = v.RemoveStakedTokens(tokensToRemove)
+	v = v.RemoveTokensProportionally(tokensToRemove)
 	k.SetValidator(ctx, v)
 	k.SetStakedValidator(ctx, v)
 	return v
@@ -44,6 +51,37 @@ func (k Keeper) getStakedValidators(ctx sdk.Ctx) types.Validators {
 	return validators
 }
 
+// get the current staked validators that service the chain, sorted by address
+func (k Keeper) GetNodesForChain(ctx sdk.Ctx, chain string) (validators []exported.ValidatorI) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyForValidatorsByChain(chain))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		validator := k.mustGetValidator(ctx, iterator.Value())
+		if validator.IsStaked() {
+			validators = append(validators, validator)
+		}
+	}
+	return validators
+}
+
+// build the chain index from the staked set once, for the validators staked before the index existed
+func (k Keeper) BuildChainIndex(ctx sdk.Ctx) {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.ChainIndexBuiltKey) {
+		return
+	}
+	iterator := k.stakedValsIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		validator := k.mustGetValidator(ctx, iterator.Value())
+		for _, chain := range validator.Chains {
+			store.Set(types.KeyForValidatorByChain(chain, validator.Address), validator.Address)
+		}
+	}
+	store.Set(types.ChainIndexBuiltKey, []byte{0x01})
+}
+
 // returns an iterator for the current staked validators
 func (k Keeper) stakedValsIterator(ctx sdk.Ctx) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
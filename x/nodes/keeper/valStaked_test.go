@@ -143,6 +143,55 @@ func TestRemoveDeleteFromStakingSet(t *testing.T) {
 	}
 }
 
+func TestGetNodesForChain(t *testing.T) {
+	chain := "b60d7bdd334cd3768d43f14a05c7fe7e886ba5bcb77e1064530052fed1a3f145"
+	otherChain := "0000000000000000000000000000000000000000000000000000000000000000"
+	stakedValidator := getStakedValidator()
+	otherValidator := getStakedValidator()
+	otherValidator.Chains = []string{otherChain}
+	multiValidator := getStakedValidator()
+	multiValidator.Chains = []string{chain, otherChain}
+
+	context, _, keeper := createTestInput(t, true)
+	for _, validator := range []types.Validator{stakedValidator, otherValidator, multiValidator} {
+		keeper.SetValidator(context, validator)
+		keeper.SetStakedValidator(context, validator)
+	}
+	assert.Len(t, keeper.GetNodesForChain(context, chain), 2)
+	assert.Len(t, keeper.GetNodesForChain(context, otherChain), 2)
+	assert.Empty(t, keeper.GetNodesForChain(context, "ab"))
+	// removing the validator from the staking set removes it from every chain index
+	keeper.deleteValidatorFromStakingSet(context, multiValidator)
+	nodes := keeper.GetNodesForChain(context, chain)
+	assert.Len(t, nodes, 1)
+	assert.Equal(t, stakedValidator.Address, nodes[0].GetAddress())
+	nodes = keeper.GetNodesForChain(context, otherChain)
+	assert.Len(t, nodes, 1)
+	assert.Equal(t, otherValidator.Address, nodes[0].GetAddress())
+	// jailed validators are not part of the index
+	keeper.JailValidator(context, stakedValidator.Address)
+	assert.Empty(t, keeper.GetNodesForChain(context, chain))
+}
+
+func TestBuildChainIndex(t *testing.T) {
+	chain := "b60d7bdd334cd3768d43f14a05c7fe7e886ba5bcb77e1064530052fed1a3f145"
+	stakedValidator := getStakedValidator()
+	context, _, keeper := createTestInput(t, true)
+	// a validator staked before the chain index existed
+	keeper.SetValidator(context, stakedValidator)
+	store := context.KVStore(keeper.storeKey)
+	store.Set(types.KeyForValidatorInStakingSet(stakedValidator), stakedValidator.Address)
+	assert.Empty(t, keeper.GetNodesForChain(context, chain))
+	keeper.BuildChainIndex(context)
+	nodes := keeper.GetNodesForChain(context, chain)
+	assert.Len(t, nodes, 1)
+	assert.Equal(t, stakedValidator.Address, nodes[0].GetAddress())
+	// the index is only built once
+	store.Delete(types.KeyForValidatorByChain(chain, stakedValidator.Address))
+	keeper.BuildChainIndex(context)
+	assert.Empty(t, keeper.GetNodesForChain(context, chain))
+}
+
 func TestGetValsIterator(t *testing.T) {
 	stakedValidator := getStakedValidator()
 	unstakedValidator := getUnstakedValidator()
@@ -26,6 +26,8 @@ func (k Keeper) UpdateTendermintValidators(ctx sdk.Ctx) (updates []abci.Validato
 	totalPower := sdk.ZeroInt()
 	// Retrieve the prevState validator set addresses mapped to their respective staking power
 	prevStatePowerMap := k.getPrevStatePowerMap(ctx)
+	// remove the consensus keys replaced by a rotation, the new keys are added below
+	updates = k.removeRotatedConsensusKeys(ctx, prevStatePowerMap)
 	// Iterate over staked validators, highest power to lowest.
 	iterator := sdk.KVStoreReversePrefixIterator(store, types.StakedValidatorsKey)
 	defer iterator.Close()
@@ -146,6 +148,105 @@ func (k Keeper) StakeValidator(ctx sdk.Ctx, validator types.Validator, amount sd
 	return nil
 }
 
+// validate check called before an already staked validator edits its stake, chains, service url or output address
+func (k Keeper) ValidateEditStake(ctx sdk.Ctx, currentValidator, validator types.Validator, amount sdk.Int, signer sdk.Address) sdk.Error {
+	// only staked validators may edit
+	if !currentValidator.IsStaked() {
+		return types.ErrValidatorStatus(k.codespace)
+	}
+	// the edit must be signed by the validator or its output address
+	if !signer.Equals(currentValidator.Address) && !signer.Equals(currentValidator.GetOutputAddress()) {
+		return types.ErrUnauthorizedSigner(k.codespace)
+	}
+	// only the output address may change the output address
+	if !validator.OutputAddress.Empty() && !validator.OutputAddress.Equals(currentValidator.GetOutputAddress()) && !signer.Equals(currentValidator.GetOutputAddress()) {
+		return types.ErrUnauthorizedSigner(k.codespace)
+	}
+	if currentValidator.IsJailed() {
+		return types.ErrValidatorJailed(k.codespace)
+	}
+	if k.IsWaitingValidator(ctx, currentValidator.Address) {
+		return types.ErrValidatorWaitingToUnstake(k.codespace)
+	}
+	// the self stake may only be increased, the delegated tokens are not part of the amount
+	diff := amount.Sub(currentValidator.GetSelfStake())
+	if diff.IsNegative() {
+		return types.ErrStakeDecrease(k.codespace)
+	}
+	coin := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), diff))
+	if !k.AccountKeeper.HasCoins(ctx, currentValidator.Address, coin) {
+		return types.ErrNotEnoughCoins(k.codespace)
+	}
+	return nil
+}
+
+// store ops when an already staked validator edits its stake, chains, service url or output address
+// NOTE the sessions generated before the edit are unaffected, the edit is part of the next session snapshot
+func (k Keeper) EditStakeValidator(ctx sdk.Ctx, currentValidator, validator types.Validator, amount sdk.Int) sdk.Error {
+	diff := amount.Sub(currentValidator.GetSelfStake())
+	// send the additional coins from address to staked module account
+	err := k.coinsFromUnstakedToStaked(ctx, currentValidator, diff)
+	if err != nil {
+		return err
+	}
+	// remove the old power and chain index entries before the validator changes
+	k.deleteValidatorFromStakingSet(ctx, currentValidator)
+	currentValidator.Chains = validator.Chains
+	currentValidator.ServiceURL = validator.ServiceURL
+	if !validator.OutputAddress.Empty() {
+		currentValidator.OutputAddress = validator.OutputAddress
+	}
+	currentValidator = currentValidator.AddStakedTokens(diff)
+	// save in the validator store
+	k.SetValidator(ctx, currentValidator)
+	// save in the staked store
+	k.SetStakedValidator(ctx, currentValidator)
+	ctx.Logger().Info("Successfully edited staked validator: " + currentValidator.Address.String())
+	return nil
+}
+
+// validate check called before a staked validator unstakes part of its stake
+func (k Keeper) ValidatePartialUnstake(ctx sdk.Ctx, validator types.Validator, amount sdk.Int) sdk.Error {
+	// must be staked to partially unstake
+	if !validator.IsStaked() {
+		return types.ErrValidatorStatus(k.codespace)
+	}
+	if validator.IsJailed() {
+		return types.ErrValidatorJailed(k.codespace)
+	}
+	if k.IsWaitingValidator(ctx, validator.Address) {
+		return types.ErrValidatorWaitingToUnstake(k.codespace)
+	}
+	if !amount.IsPositive() {
+		return types.ErrBadDelegationAmount(k.codespace)
+	}
+	// only the validator's own stake may be unstaked and it must stay above the minimum, otherwise it must begin unstaking
+	if validator.GetSelfStake().Sub(amount).LT(sdk.NewInt(k.MinimumStake(ctx))) {
+		return types.ErrUnstakeBelowMinimum(k.codespace)
+	}
+	return nil
+}
+
+// store ops when a staked validator unstakes part of its stake -> the tokens are released after the unstaking time
+// NOTE the power change is sent to tendermint in the end blocker by UpdateTendermintValidators
+func (k Keeper) PartialUnstakeValidator(ctx sdk.Ctx, validator types.Validator, amount sdk.Int) {
+	// remove the old power index entry before the tokens change
+	k.deleteValidatorFromStakingSet(ctx, validator)
+	validator = validator.RemoveStakedTokens(amount)
+	// save in the validator store
+	k.SetValidator(ctx, validator)
+	// save in the staked store
+	k.SetStakedValidator(ctx, validator)
+	// add to the partial unstaking queue, the tokens stay in the staked pool until released
+	k.SetPartialUnstake(ctx, types.PartialUnstake{
+		Address:        validator.Address,
+		Amount:         amount,
+		CompletionTime: ctx.BlockHeader().Time.Add(k.UnStakingTime(ctx)),
+		CreationHeight: ctx.BlockHeight(),
+	})
+	ctx.Logger().Info("Began partial unstake of " + amount.String() + " from validator " + validator.Address.String())
+}
+
 func (k Keeper) ValidateValidatorBeginUnstaking(ctx sdk.Ctx, validator types.Validator) sdk.Error {
 	// must be staked to begin unstaking
 	if !validator.IsStaked() {
@@ -173,12 +274,15 @@ func (k Keeper) ReleaseWaitingValidators(ctx sdk.Ctx) {
 		if err := k.ValidateValidatorBeginUnstaking(ctx, val); err == nil {
 			// if able to begin unstaking
 			k.BeginUnstakingValidator(ctx, val)
+			val, _ = k.GetValidator(ctx, val.Address)
 			// create the event
 			ctx.EventManager().EmitEvents(sdk.Events{
 				sdk.NewEvent(
 					types.EventTypeBeginUnstake,
 					sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 					sdk.NewAttribute(sdk.AttributeKeySender, val.Address.String()),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, val.StakedTokens.String()),
+					sdk.NewAttribute(types.AttributeKeyCompletionTime, val.UnstakingCompletionTime.String()),
 				),
 				sdk.NewEvent(
 					sdk.EventTypeMessage,
@@ -231,6 +335,8 @@ func (k Keeper) ValidateValidatorFinishUnstaking(ctx sdk.Ctx, validator types.Va
 func (k Keeper) FinishUnstakingValidator(ctx sdk.Ctx, validator types.Validator) {
 	// delete the validator from the unstaking queue
 	k.deleteUnstakingValidator(ctx, validator)
+	// return the delegated tokens to the delegators
+	validator = k.payoutDelegations(ctx, validator)
 	// amount unstaked = stakedTokens
 	amount := sdk.NewInt(validator.StakedTokens.Int64())
 	// send the tokens from staking module account to validator account
@@ -268,6 +374,8 @@ func (k Keeper) ForceValidatorUnstake(ctx sdk.Ctx, validator types.Validator) sd
 	default:
 		panic(sdk.ErrInternal("trying to force unstake an already unstaked validator"))
 	}
+	// queue the delegated tokens to be returned to the delegators, only the validator's own stake is burned
+	validator = k.unstakeDelegations(ctx, validator)
 	// amount unstaked = stakedTokens
 	err := k.burnStakedTokens(ctx, validator.StakedTokens)
 	if err != nil {
@@ -310,7 +418,12 @@ func (k Keeper) JailValidator(ctx sdk.Ctx, addr sdk.Address) {
 }
 
 func (k Keeper) ValidateUnjailMessage(ctx sdk.Ctx, msg types.MsgUnjail) (addr sdk.Address, err sdk.Error) {
-	validator := k.Validator(ctx, msg.ValidatorAddr)
+	return k.ValidateUnjail(ctx, msg.ValidatorAddr)
+}
+
+// validate check called before a validator is unjailed, returns the address of the validator
+func (k Keeper) ValidateUnjail(ctx sdk.Ctx, address sdk.Address) (addr sdk.Address, err sdk.Error) {
+	validator := k.Validator(ctx, address)
 	if validator == nil {
 		return nil, types.ErrNoValidatorForAddress(k.Codespace())
 	}
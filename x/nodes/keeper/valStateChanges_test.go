@@ -330,3 +330,193 @@ func TestKeeper_WaitToBeginUnstakingValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestKeeper_EditStakeValidator(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getUnstakedValidator()
+	validator.StakedTokens = sdk.ZeroInt()
+	stakeAmount := sdk.NewInt(100000000000)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, validator.Address, stakeAmount.Add(sdk.NewInt(1000)))
+	assert.Nil(t, keeper.StakeValidator(context, validator, stakeAmount))
+	current, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	oldChain := current.Chains[0]
+	newChain := "0000000000000000000000000000000000000000000000000000000000000000"
+	edited := current
+	edited.Chains = []string{newChain}
+	edited.ServiceURL = "https://pokt.network:8081"
+	// the stake may not decrease
+	assert.Equal(t, types.ErrStakeDecrease(keeper.codespace), keeper.ValidateEditStake(context, current, edited, stakeAmount.Sub(sdk.OneInt()), current.Address))
+	// the account must hold the additional stake
+	assert.Equal(t, types.ErrNotEnoughCoins(keeper.codespace), keeper.ValidateEditStake(context, current, edited, stakeAmount.Add(sdk.NewInt(1001)), current.Address))
+	// unstaked validators can't edit
+	assert.Equal(t, types.ErrValidatorStatus(keeper.codespace), keeper.ValidateEditStake(context, current.UpdateStatus(sdk.Unstaked), edited, stakeAmount, current.Address))
+	newStake := stakeAmount.Add(sdk.NewInt(1000))
+	assert.Nil(t, keeper.ValidateEditStake(context, current, edited, newStake, current.Address))
+	assert.Nil(t, keeper.EditStakeValidator(context, current, edited, newStake))
+	updated, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	assert.Equal(t, newStake, updated.StakedTokens)
+	assert.Equal(t, []string{newChain}, updated.Chains)
+	assert.Equal(t, "https://pokt.network:8081", updated.ServiceURL)
+	assert.True(t, updated.IsStaked())
+	// the power index holds a single entry with the new stake
+	staked := keeper.getStakedValidators(context)
+	assert.Len(t, staked, 1)
+	assert.Equal(t, newStake, staked[0].StakedTokens)
+	// the chain index follows the new chains
+	assert.Empty(t, keeper.GetNodesForChain(context, oldChain))
+	assert.Len(t, keeper.GetNodesForChain(context, newChain), 1)
+	// validators waiting to unstake can't edit
+	keeper.SetWaitingValidator(context, updated)
+	assert.Equal(t, types.ErrValidatorWaitingToUnstake(keeper.codespace), keeper.ValidateEditStake(context, updated, edited, newStake, updated.Address))
+}
+
+func TestKeeper_EditStakeValidatorDelegated(t *testing.T) {
+	context, accs, keeper := createTestInput(t, true)
+	validator := getUnstakedValidator()
+	validator.StakedTokens = sdk.ZeroInt()
+	stakeAmount := sdk.NewInt(100000000000)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, validator.Address, stakeAmount.Add(sdk.NewInt(1000)))
+	assert.Nil(t, keeper.StakeValidator(context, validator, stakeAmount))
+	current, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	assert.Nil(t, keeper.Delegate(context, accs[0].GetAddress(), current, sdk.NewInt(5000)))
+	current, found = keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	edited := current
+	edited.ServiceURL = "https://pokt.network:8081"
+	// the amount is the self stake, the delegated tokens don't count
+	assert.Equal(t, types.ErrStakeDecrease(keeper.codespace), keeper.ValidateEditStake(context, current, edited, stakeAmount.Sub(sdk.OneInt()), current.Address))
+	assert.Nil(t, keeper.ValidateEditStake(context, current, edited, stakeAmount, current.Address))
+	newStake := stakeAmount.Add(sdk.NewInt(1000))
+	assert.Nil(t, keeper.ValidateEditStake(context, current, edited, newStake, current.Address))
+	assert.Nil(t, keeper.EditStakeValidator(context, current, edited, newStake))
+	updated, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	assert.Equal(t, newStake, updated.GetSelfStake())
+	assert.Equal(t, sdk.NewInt(5000), updated.GetDelegatedTokens())
+}
+
+func TestKeeper_EditStakeValidatorOutputAddress(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getUnstakedValidator()
+	validator.StakedTokens = sdk.ZeroInt()
+	stakeAmount := sdk.NewInt(100000000000)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, validator.Address, stakeAmount)
+	assert.Nil(t, keeper.StakeValidator(context, validator, stakeAmount))
+	current, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	output := getRandomValidatorAddress()
+	edited := current
+	edited.OutputAddress = output
+	// only the validator or its output address may sign an edit
+	assert.Equal(t, types.ErrUnauthorizedSigner(keeper.codespace), keeper.ValidateEditStake(context, current, edited, stakeAmount, output))
+	// without an output address the validator sets it
+	assert.Nil(t, keeper.ValidateEditStake(context, current, edited, stakeAmount, current.Address))
+	assert.Nil(t, keeper.EditStakeValidator(context, current, edited, stakeAmount))
+	updated, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	assert.Equal(t, output, updated.GetOutputAddress())
+	// once set, only the output address may change it
+	edited = updated
+	edited.OutputAddress = getRandomValidatorAddress()
+	assert.Equal(t, types.ErrUnauthorizedSigner(keeper.codespace), keeper.ValidateEditStake(context, updated, edited, stakeAmount, updated.Address))
+	assert.Nil(t, keeper.ValidateEditStake(context, updated, edited, stakeAmount, output))
+	// the validator may still edit when leaving the output address unchanged
+	edited.OutputAddress = nil
+	assert.Nil(t, keeper.ValidateEditStake(context, updated, edited, stakeAmount, updated.Address))
+	assert.Nil(t, keeper.EditStakeValidator(context, updated, edited, stakeAmount))
+	updated, found = keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	assert.Equal(t, output, updated.GetOutputAddress())
+}
+
+func TestKeeper_ValidatePartialUnstake(t *testing.T) {
+	jailedValidator := getStakedValidator()
+	jailedValidator.Jailed = true
+	tests := []struct {
+		name      string
+		validator types.Validator
+		amount    sdk.Int
+		want      sdk.Error
+	}{
+		{"validates partial unstake", getStakedValidator(), sdk.NewInt(100000000000 - types.DefaultMinStake), nil},
+		{"errors if validator not staked", getUnstakingValidator(), sdk.NewInt(1), types.ErrValidatorStatus("pos")},
+		{"errors if validator jailed", jailedValidator, sdk.NewInt(1), types.ErrValidatorJailed("pos")},
+		{"errors if amount is not positive", getStakedValidator(), sdk.ZeroInt(), types.ErrBadDelegationAmount("pos")},
+		{"errors if the stake left is below the minimum", getStakedValidator(), sdk.NewInt(100000000000 - types.DefaultMinStake + 1), types.ErrUnstakeBelowMinimum("pos")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			context, _, keeper := createTestInput(t, true)
+			assert.Equal(t, tt.want, keeper.ValidatePartialUnstake(context, tt.validator, tt.amount))
+		})
+	}
+}
+
+func TestKeeper_PartialUnstakeValidator(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getUnstakedValidator()
+	validator.StakedTokens = sdk.ZeroInt()
+	validator.OutputAddress = getRandomValidatorAddress()
+	stakeAmount := sdk.NewInt(100000000000)
+	amount := sdk.NewInt(40000000000)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, validator.Address, stakeAmount)
+	assert.Nil(t, keeper.StakeValidator(context, validator, stakeAmount))
+	keeper.UpdateTendermintValidators(context)
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	assert.Nil(t, keeper.ValidatePartialUnstake(context, validator, amount))
+	keeper.PartialUnstakeValidator(context, validator, amount)
+	updated, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	assert.True(t, updated.IsStaked())
+	assert.True(t, updated.StakedTokens.Equal(stakeAmount.Sub(amount)))
+	// the power change is sent to tendermint
+	updates := keeper.UpdateTendermintValidators(context)
+	assert.Len(t, updates, 1)
+	assert.Equal(t, updated.ConsensusPower(), updates[0].Power)
+	partialUnstakes := keeper.GetAllPartialUnstakes(context)
+	assert.Len(t, partialUnstakes, 1)
+	assert.True(t, partialUnstakes[0].Amount.Equal(amount))
+	// not released before the unstaking time
+	keeper.unstakeAllMaturePartialUnstakes(context)
+	assert.True(t, keeper.AccountKeeper.GetCoins(context, validator.OutputAddress).AmountOf(keeper.StakeDenom(context)).IsZero())
+	// released to the output address at the completion time
+	context = context.WithBlockTime(partialUnstakes[0].CompletionTime)
+	keeper.unstakeAllMaturePartialUnstakes(context)
+	assert.True(t, keeper.AccountKeeper.GetCoins(context, validator.OutputAddress).AmountOf(keeper.StakeDenom(context)).Equal(amount))
+	assert.Len(t, keeper.GetAllPartialUnstakes(context), 0)
+}
+
+func TestKeeper_SlashPartialUnstakes(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getUnstakedValidator()
+	validator.StakedTokens = sdk.ZeroInt()
+	stakeAmount := sdk.NewInt(100000000000)
+	amount := sdk.NewInt(40000000000)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, validator.Address, stakeAmount)
+	assert.Nil(t, keeper.StakeValidator(context, validator, stakeAmount))
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	keeper.PartialUnstakeValidator(context, validator, amount)
+	power := sdk.TokensToConsensusPower(stakeAmount)
+	// an infraction after the partial unstake does not reach the unstaked tokens
+	keeper.slash(context.WithBlockHeight(context.BlockHeight()+1), validator.Address, context.BlockHeight()+1, power, sdk.NewDecWithPrec(1, 1), types.SlashReasonDoubleSign)
+	partialUnstakes := keeper.GetAllPartialUnstakes(context)
+	assert.Len(t, partialUnstakes, 1)
+	assert.True(t, partialUnstakes[0].Amount.Equal(amount))
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	assert.True(t, validator.StakedTokens.Equal(sdk.NewInt(50000000000)))
+	// an infraction before the partial unstake slashes the unstaked tokens first
+	keeper.slash(context, validator.Address, context.BlockHeight(), power, sdk.NewDecWithPrec(1, 1), types.SlashReasonDoubleSign)
+	partialUnstakes = keeper.GetAllPartialUnstakes(context)
+	assert.Len(t, partialUnstakes, 1)
+	assert.True(t, partialUnstakes[0].Amount.Equal(sdk.NewInt(36000000000)))
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	assert.True(t, validator.StakedTokens.Equal(sdk.NewInt(44000000000)))
+}
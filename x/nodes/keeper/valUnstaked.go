@@ -147,3 +147,129 @@ func (k Keeper) unstakeAllMatureValidators(ctx sdk.Ctx) {
 		store.Delete(unstakingValidatorsIterator.Key())
 	}
 }
+
+// set a partial unstake in the queue, adding to the amount of an entry with the same completion time
+func (k Keeper) SetPartialUnstake(ctx sdk.Ctx, pu types.PartialUnstake) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyForPartialUnstake(pu.CompletionTime, pu.Address)
+	if bz := store.Get(key); bz != nil {
+		pu.Amount = pu.Amount.Add(types.MustUnmarshalPartialUnstake(k.cdc, bz).Amount)
+	}
+	store.Set(key, types.MustMarshalPartialUnstake(k.cdc, pu))
+}
+
+// get all of the partial unstakes sorted by completion time
+func (k Keeper) GetAllPartialUnstakes(ctx sdk.Ctx) (pus []types.PartialUnstake) {
+	pus = make([]types.PartialUnstake, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PartialUnstakingKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		pus = append(pus, types.MustUnmarshalPartialUnstake(k.cdc, iterator.Value()))
+	}
+	return pus
+}
+
+// slash the partial unstakes of a validator created at or after the infraction height, the tokens were at stake
+// when the infraction was committed -> returns the amount burned
+func (k Keeper) slashPartialUnstakes(ctx sdk.Ctx, addr sdk.Address, infractionHeight int64, slashFactor sdk.Dec) sdk.Int {
+	burned := sdk.ZeroInt()
+	store := ctx.KVStore(k.storeKey)
+	for _, pu := range k.GetAllPartialUnstakes(ctx) {
+		if !pu.Address.Equals(addr) || pu.CreationHeight < infractionHeight {
+			continue
+		}
+		slashAmount := sdk.MinInt(slashFactor.MulInt(pu.Amount).TruncateInt(), pu.Amount)
+		if !slashAmount.IsPositive() {
+			continue
+		}
+		key := types.KeyForPartialUnstake(pu.CompletionTime, pu.Address)
+		pu.Amount = pu.Amount.Sub(slashAmount)
+		if pu.Amount.IsZero() {
+			store.Delete(key)
+		} else {
+			store.Set(key, types.MustMarshalPartialUnstake(k.cdc, pu))
+		}
+		burned = burned.Add(slashAmount)
+	}
+	if err := k.burnStakedTokens(ctx, burned); err != nil {
+		panic(err)
+	}
+	return burned
+}
+
+// release the tokens of all the mature partial unstakes -> called in the end blocker
+func (k Keeper) unstakeAllMaturePartialUnstakes(ctx sdk.Ctx) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.PartialUnstakingKey, sdk.PrefixEndBytes(types.KeyForPartialUnstakes(ctx.BlockHeader().Time)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		pu := types.MustUnmarshalPartialUnstake(k.cdc, iterator.Value())
+		// the tokens go to the output address of the validator if one is set
+		recipient := pu.Address
+		if val, found := k.GetValidator(ctx, pu.Address); found {
+			recipient = val.GetOutputAddress()
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), pu.Amount))
+		if err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, recipient, coins); err != nil {
+			panic(err)
+		}
+		store.Delete(iterator.Key())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompletePartialUnstake,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyValidator, pu.Address.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, pu.Amount.String()),
+			),
+		)
+		ctx.Logger().Info("Finished partial unstake of " + pu.Amount.String() + " from validator " + pu.Address.String())
+	}
+}
+
+// get the unstaking schedule of all the validators, or of one if the address is not empty, sorted by completion time
+func (k Keeper) GetUnstakingSchedule(ctx sdk.Ctx, addr sdk.Address) []types.UnstakingEntry {
+	entries := make([]types.UnstakingEntry, 0)
+	// the waiting validators begin unstaking the block before the next session block, the completion time is only known
+	// once they begin so it is left unset
+	frequency := k.SessionBlockFrequency(ctx)
+	beginHeight := (ctx.BlockHeight()/frequency + 1) * frequency
+	for _, waiting := range k.GetWaitingValidators(ctx) {
+		if len(addr) != 0 && !waiting.Address.Equals(addr) {
+			continue
+		}
+		validator, found := k.GetValidator(ctx, waiting.Address)
+		if !found {
+			continue
+		}
+		entries = append(entries, types.UnstakingEntry{
+			Address:     validator.Address,
+			Amount:      validator.GetSelfStake(),
+			Waiting:     true,
+			BeginHeight: beginHeight,
+		})
+	}
+	for _, validator := range k.getAllUnstakingValidators(ctx) {
+		if len(addr) != 0 && !validator.Address.Equals(addr) {
+			continue
+		}
+		entries = append(entries, types.UnstakingEntry{
+			Address:        validator.Address,
+			Amount:         validator.GetSelfStake(),
+			CompletionTime: validator.UnstakingCompletionTime,
+		})
+	}
+	for _, pu := range k.GetAllPartialUnstakes(ctx) {
+		if len(addr) != 0 && !pu.Address.Equals(addr) {
+			continue
+		}
+		entries = append(entries, types.UnstakingEntry{
+			Address:        pu.Address,
+			Amount:         pu.Amount,
+			CompletionTime: pu.CompletionTime,
+			Partial:        true,
+		})
+	}
+	types.SortUnstakingEntries(entries)
+	return entries
+}
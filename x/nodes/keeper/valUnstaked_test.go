@@ -5,6 +5,7 @@ import (
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestGetAndSetlUnstaking(t *testing.T) {
@@ -278,3 +279,37 @@ func TestUnstakingValidatorsIterator(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUnstakingSchedule(t *testing.T) {
+	unstaking := getUnstakingValidator()
+	unstaking.UnstakingCompletionTime = time.Unix(200, 0).UTC()
+	waiting := getStakedValidator()
+	context, _, keeper := createTestInput(t, true)
+	keeper.SetValidator(context, unstaking)
+	keeper.SetUnstakingValidator(context, unstaking)
+	keeper.SetValidator(context, waiting)
+	keeper.SetWaitingValidator(context, waiting)
+	keeper.SetPartialUnstake(context, types.PartialUnstake{Address: waiting.Address, Amount: sdk.NewInt(10), CompletionTime: time.Unix(100, 0).UTC()})
+	schedule := keeper.GetUnstakingSchedule(context, sdk.Address{})
+	assert.Len(t, schedule, 3)
+	// sorted by completion time
+	assert.True(t, schedule[0].Partial)
+	assert.True(t, time.Unix(100, 0).Equal(schedule[0].CompletionTime))
+	assert.True(t, unstaking.UnstakingCompletionTime.Equal(schedule[1].CompletionTime))
+	// the waiting validator comes last, it has no completion time until it begins unstaking at the next session block
+	entry := schedule[2]
+	assert.True(t, entry.Waiting)
+	assert.Equal(t, waiting.Address, entry.Address)
+	assert.Equal(t, waiting.GetSelfStake(), entry.Amount)
+	assert.True(t, entry.CompletionTime.IsZero())
+	frequency := keeper.SessionBlockFrequency(context)
+	assert.Equal(t, (context.BlockHeight()/frequency+1)*frequency, entry.BeginHeight)
+	// a single validator
+	schedule = keeper.GetUnstakingSchedule(context, unstaking.Address)
+	assert.Len(t, schedule, 1)
+	assert.False(t, schedule[0].Waiting)
+	assert.Equal(t, unstaking.GetSelfStake(), schedule[0].Amount)
+	assert.True(t, unstaking.UnstakingCompletionTime.Equal(schedule[0].CompletionTime))
+	schedule = keeper.GetUnstakingSchedule(context, waiting.Address)
+	assert.Len(t, schedule, 2)
+}
@@ -56,9 +56,9 @@ func (k Keeper) mustGetValidator(ctx sdk.Ctx, addr sdk.Address) types.Validator
 	return validator
 }
 
-// wrapper for GetValidator call
+// wrapper for GetValidator call, the address of a rotated consensus key resolves to its validator
 func (k Keeper) Validator(ctx sdk.Ctx, address sdk.Address) exported.ValidatorI {
-	val, found := k.GetValidator(ctx, address)
+	val, found := k.GetValidator(ctx, k.ValidatorAddressFromConsensusAddress(ctx, address))
 	if !found {
 		return nil
 	}
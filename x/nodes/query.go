@@ -64,15 +64,24 @@ func QueryValidator(cdc *codec.Codec, tmNode rpcclient.Client, addr sdk.Address,
 	return types.MustUnmarshalValidator(cdc, res), nil
 }
 
-func QueryValidators(cdc *codec.Codec, tmNode rpcclient.Client, height int64) (types.Validators, error) {
+func QueryValidators(cdc *codec.Codec, tmNode rpcclient.Client, height int64, opts types.QueryValidatorsParams) (types.Validators, error) {
 	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
-	resKVs, _, err := cliCtx.QuerySubspace(types.AllValidatorsKey, types.StoreKey)
+	bz, err := cdc.MarshalJSON(opts)
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryValidators), bz)
 	if err != nil {
 		return types.Validators{}, err
 	}
-	validators := make(types.Validators, 0)
-	for _, kv := range resKVs {
-		validators = append(validators, types.MustUnmarshalValidator(cdc, kv.Value))
+	validators := types.Validators{}
+	err = cdc.UnmarshalJSON(res, &validators)
+	if err != nil {
+		return validators, err
+	}
+	// amino decodes an empty page as nil
+	if validators == nil {
+		validators = make(types.Validators, 0)
 	}
 	return validators, nil
 }
@@ -231,3 +240,86 @@ func QueryNodeStatus(tmNode rpcclient.Client) (*ctypes.ResultStatus, error) {
 	}
 	return res, nil
 }
+
+func QueryDelegations(cdc *codec.Codec, tmNode rpcclient.Client, addr sdk.Address, height int64) ([]types.Delegation, error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(addr))
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryDelegations), bz)
+	if err != nil {
+		return nil, err
+	}
+	var delegations []types.Delegation
+	err = cdc.UnmarshalJSON(res, &delegations)
+	return delegations, err
+}
+
+func QueryUnstakingDelegations(cdc *codec.Codec, tmNode rpcclient.Client, addr sdk.Address, height int64) ([]types.UnstakingDelegation, error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(addr))
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryUnstakingDelegations), bz)
+	if err != nil {
+		return nil, err
+	}
+	var uds []types.UnstakingDelegation
+	err = cdc.UnmarshalJSON(res, &uds)
+	return uds, err
+}
+
+func QuerySlashRecords(cdc *codec.Codec, tmNode rpcclient.Client, addr sdk.Address, height int64) ([]types.SlashRecord, error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.NewQueryValidatorParams(addr))
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QuerySlashRecords), bz)
+	if err != nil {
+		return nil, err
+	}
+	var records []types.SlashRecord
+	err = cdc.UnmarshalJSON(res, &records)
+	if records == nil {
+		// amino decodes an empty list as nil
+		records = make([]types.SlashRecord, 0)
+	}
+	return records, err
+}
+
+func QueryEarnings(cdc *codec.Codec, tmNode rpcclient.Client, addr sdk.Address, height int64) (types.ValidatorEarnings, error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.NewQueryValidatorParams(addr))
+	if err != nil {
+		return types.ValidatorEarnings{}, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryEarnings), bz)
+	if err != nil {
+		return types.ValidatorEarnings{}, err
+	}
+	var earnings types.ValidatorEarnings
+	err = cdc.UnmarshalJSON(res, &earnings)
+	return earnings, err
+}
+
+func QueryUnstakingSchedule(cdc *codec.Codec, tmNode rpcclient.Client, addr sdk.Address, height int64) ([]types.UnstakingEntry, error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.NewQueryValidatorParams(addr))
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryUnstakingSchedule), bz)
+	if err != nil {
+		return nil, err
+	}
+	var entries []types.UnstakingEntry
+	err = cdc.UnmarshalJSON(res, &entries)
+	if entries == nil {
+		// amino decodes an empty list as nil
+		entries = make([]types.UnstakingEntry, 0)
+	}
+	return entries, err
+}
@@ -410,6 +410,7 @@ func TestQueryValidators(t *testing.T) {
 		cdc    *codec.Codec
 		tmNode client.Client
 		height int64
+		opts   types.QueryValidatorsParams
 	}
 	tests := []struct {
 		name    string
@@ -421,11 +422,12 @@ func TestQueryValidators(t *testing.T) {
 			cdc:    makeTestCodec(),
 			tmNode: GetTestTendermintClient(),
 			height: 0,
+			opts:   types.NewQueryValidatorsParams(1, 0),
 		}, types.Validators{}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := QueryValidators(tt.args.cdc, tt.args.tmNode, tt.args.height)
+			got, err := QueryValidators(tt.args.cdc, tt.args.tmNode, tt.args.height, tt.args.opts)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("QueryValidators() error = %v, wantErr %v", err, tt.wantErr)
 				return
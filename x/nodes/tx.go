@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/pokt-network/pocket-core/x/nodes/types"
 	"github.com/pokt-network/posmint/codec"
+	"github.com/pokt-network/posmint/crypto"
 	"github.com/pokt-network/posmint/crypto/keys"
 	"github.com/pokt-network/posmint/crypto/keys/mintkey"
 	sdk "github.com/pokt-network/posmint/types"
@@ -12,13 +13,14 @@ import (
 	"github.com/tendermint/tendermint/rpc/client"
 )
 
-func StakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, chains []string, serviceURL string, amount sdk.Int, kp keys.KeyPair, passphrase string) (*sdk.TxResponse, error) {
+func StakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, chains []string, serviceURL string, amount sdk.Int, kp keys.KeyPair, output sdk.Address, passphrase string) (*sdk.TxResponse, error) {
 	fromAddr := kp.GetAddress()
 	msg := types.MsgStake{
-		PublicKey:  kp.PublicKey,
-		Value:      amount,
-		ServiceURL: serviceURL, // url where pocket service api is hosted
-		Chains:     chains,     // non native blockchains
+		PublicKey:     kp.PublicKey,
+		Value:         amount,
+		ServiceURL:    serviceURL, // url where pocket service api is hosted
+		Chains:        chains,     // non native blockchains
+		OutputAddress: output,     // optional address that receives the rewards and unstaked tokens
 	}
 	txBuilder, cliCtx := newTx(cdc, msg, fromAddr, tmNode, keybase, passphrase)
 	err := msg.ValidateBasic()
@@ -28,9 +30,126 @@ func StakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, chain
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
 }
 
-func UnstakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, address sdk.Address, passphrase string) (*sdk.TxResponse, error) {
+func UnstakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, address, signer sdk.Address, passphrase string) (*sdk.TxResponse, error) {
 	msg := types.MsgBeginUnstake{Address: address}
-	txBuilder, cliCtx := newTx(cdc, msg, address, tmNode, keybase, passphrase)
+	// the output address of the validator signs in place of the validator
+	if !signer.Equals(address) {
+		msg.Signer = signer
+	}
+	txBuilder, cliCtx := newTx(cdc, msg, signer, tmNode, keybase, passphrase)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
+func PartialUnstakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, address, signer sdk.Address, amount sdk.Int, passphrase string) (*sdk.TxResponse, error) {
+	msg := types.MsgPartialUnstake{Address: address, Amount: amount}
+	// the output address of the validator signs in place of the validator
+	if !signer.Equals(address) {
+		msg.Signer = signer
+	}
+	txBuilder, cliCtx := newTx(cdc, msg, signer, tmNode, keybase, passphrase)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
+func RotateConsensusKeyTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, address, signer sdk.Address, publicKey crypto.PublicKey, passphrase string) (*sdk.TxResponse, error) {
+	msg := types.MsgRotateConsensusKey{Address: address, PublicKey: publicKey}
+	// the output address of the validator signs in place of the validator
+	if !signer.Equals(address) {
+		msg.Signer = signer
+	}
+	txBuilder, cliCtx := newTx(cdc, msg, signer, tmNode, keybase, passphrase)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
+func ChangeOutputTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, address, output, signer sdk.Address, passphrase string) (*sdk.TxResponse, error) {
+	validator, err := QueryValidator(cdc, tmNode, address, 0)
+	if err != nil {
+		return nil, err
+	}
+	// restake with the current stake, chains and service url and only change the output address
+	msg := types.MsgStake{
+		PublicKey:     validator.PublicKey,
+		Value:         validator.StakedTokens,
+		ServiceURL:    validator.ServiceURL,
+		Chains:        validator.Chains,
+		OutputAddress: output,
+	}
+	// the stake message is signed by the consensus key address unless a signer is set
+	if !signer.Equals(sdk.Address(validator.PublicKey.Address())) {
+		msg.Signer = signer
+	}
+	txBuilder, cliCtx := newTx(cdc, msg, signer, tmNode, keybase, passphrase)
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
+func DelegateTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, delegator, validator sdk.Address, amount sdk.Int, passphrase string) (*sdk.TxResponse, error) {
+	msg := types.MsgDelegate{
+		DelegatorAddress: delegator,
+		ValidatorAddress: validator,
+		Amount:           amount,
+	}
+	txBuilder, cliCtx := newTx(cdc, msg, delegator, tmNode, keybase, passphrase)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
+func UndelegateTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, delegator, validator sdk.Address, amount sdk.Int, passphrase string) (*sdk.TxResponse, error) {
+	msg := types.MsgUndelegate{
+		DelegatorAddress: delegator,
+		ValidatorAddress: validator,
+		Amount:           amount,
+	}
+	txBuilder, cliCtx := newTx(cdc, msg, delegator, tmNode, keybase, passphrase)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
+func RedelegateTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, delegator, srcValidator, dstValidator sdk.Address, amount sdk.Int, passphrase string) (*sdk.TxResponse, error) {
+	msg := types.MsgRedelegate{
+		DelegatorAddress:    delegator,
+		ValidatorSrcAddress: srcValidator,
+		ValidatorDstAddress: dstValidator,
+		Amount:              amount,
+	}
+	txBuilder, cliCtx := newTx(cdc, msg, delegator, tmNode, keybase, passphrase)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
+func SetCommissionTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, address, signer sdk.Address, commission sdk.Dec, passphrase string) (*sdk.TxResponse, error) {
+	msg := types.MsgSetCommission{
+		Address:    address,
+		Commission: commission,
+	}
+	// the output address of the validator signs in place of the validator
+	if !signer.Equals(address) {
+		msg.Signer = signer
+	}
+	txBuilder, cliCtx := newTx(cdc, msg, signer, tmNode, keybase, passphrase)
 	err := msg.ValidateBasic()
 	if err != nil {
 		return nil, err
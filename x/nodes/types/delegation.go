@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"github.com/pokt-network/posmint/codec"
+	sdk "github.com/pokt-network/posmint/types"
+	"time"
+)
+
+// Delegation - the shares of a validator held by a delegator
+type Delegation struct {
+	DelegatorAddress sdk.Address `json:"delegator_address" yaml:"delegator_address"` // the account that delegated the tokens
+	ValidatorAddress sdk.Address `json:"validator_address" yaml:"validator_address"` // the validator the tokens are delegated to
+	Shares           sdk.Dec     `json:"shares" yaml:"shares"`                       // the shares of the validator delegated tokens
+}
+
+// NewDelegation - initialize a new delegation
+func NewDelegation(delegator, validator sdk.Address, shares sdk.Dec) Delegation {
+	return Delegation{
+		DelegatorAddress: delegator,
+		ValidatorAddress: validator,
+		Shares:           shares,
+	}
+}
+
+// HashString returns a human readable string representation of a delegation.
+func (d Delegation) String() string {
+	return fmt.Sprintf("Delegator:\t\t%s\nValidator:\t\t%s\nShares:\t\t\t%s", d.DelegatorAddress, d.ValidatorAddress, d.Shares)
+}
+
+// MUST return the amino encoded version of this delegation
+func MustMarshalDelegation(cdc *codec.Codec, delegation Delegation) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(delegation)
+}
+
+// MUST decode the delegation from the bytes
+func MustUnmarshalDelegation(cdc *codec.Codec, bz []byte) (delegation Delegation) {
+	cdc.MustUnmarshalBinaryLengthPrefixed(bz, &delegation)
+	return
+}
+
+// UnstakingDelegation - delegated tokens waiting for the unstaking time to be returned to the delegator
+type UnstakingDelegation struct {
+	DelegatorAddress sdk.Address `json:"delegator_address" yaml:"delegator_address"` // the account that receives the tokens
+	ValidatorAddress sdk.Address `json:"validator_address" yaml:"validator_address"` // the validator the tokens were delegated to
+	Amount           sdk.Int     `json:"amount" yaml:"amount"`                       // the tokens to be returned
+	CompletionTime   time.Time   `json:"completion_time" yaml:"completion_time"`     // min time for the tokens to be returned
+	CreationHeight   int64       `json:"creation_height" yaml:"creation_height"`     // the height the tokens were undelegated at, they are slashable for infractions before it
+}
+
+// HashString returns a human readable string representation of an unstaking delegation.
+func (ud UnstakingDelegation) String() string {
+	return fmt.Sprintf("Delegator:\t\t%s\nValidator:\t\t%s\nAmount:\t\t\t%s\nCompletion Time:\t\t%v",
+		ud.DelegatorAddress, ud.ValidatorAddress, ud.Amount, ud.CompletionTime)
+}
+
+// MUST return the amino encoded version of this unstaking delegation
+func MustMarshalUnstakingDelegation(cdc *codec.Codec, ud UnstakingDelegation) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(ud)
+}
+
+// MUST decode the unstaking delegation from the bytes
+func MustUnmarshalUnstakingDelegation(cdc *codec.Codec, bz []byte) (ud UnstakingDelegation) {
+	cdc.MustUnmarshalBinaryLengthPrefixed(bz, &ud)
+	return
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"github.com/pokt-network/posmint/codec"
+	sdk "github.com/pokt-network/posmint/types"
+	"strings"
+)
+
+// types of an earning
+const (
+	EarningTypeRelay    = "relay"
+	EarningTypeProposer = "proposer"
+)
+
+// Earning - the tokens minted to a validator at a height
+type Earning struct {
+	Address sdk.Address `json:"address" yaml:"address"` // the rewarded validator
+	Height  int64       `json:"height" yaml:"height"`   // the height the tokens were minted at
+	Type    string      `json:"type" yaml:"type"`       // relay or proposer
+	Amount  sdk.Int     `json:"amount" yaml:"amount"`   // the tokens minted to the validator (without the delegators share)
+}
+
+// HashString returns a human readable string representation of an earning.
+func (e Earning) String() string {
+	return fmt.Sprintf("Height:\t\t\t%d\nType:\t\t\t%s\nAmount:\t\t\t%s", e.Height, e.Type, e.Amount)
+}
+
+// MUST return the amino encoded version of this earning
+func MustMarshalEarning(cdc *codec.Codec, earning Earning) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(earning)
+}
+
+// MUST decode the earning from the bytes
+func MustUnmarshalEarning(cdc *codec.Codec, bz []byte) (earning Earning) {
+	cdc.MustUnmarshalBinaryLengthPrefixed(bz, &earning)
+	return
+}
+
+// ValidatorEarnings - the earnings history of a validator and the rewards and burns pending for the next block
+type ValidatorEarnings struct {
+	Address            sdk.Address `json:"address" yaml:"address"`                           // the validator
+	PendingRelayReward sdk.Int     `json:"pending_relay_reward" yaml:"pending_relay_reward"` // the relay reward minted at the next block (shared with the delegators)
+	PendingBurn        sdk.Int     `json:"pending_burn" yaml:"pending_burn"`                 // the tokens burned at the next block
+	Earnings           []Earning   `json:"earnings" yaml:"earnings"`                         // the retained earnings sorted by height
+}
+
+// HashString returns a human readable string representation of the earnings of a validator.
+func (ve ValidatorEarnings) String() string {
+	earnings := make([]string, 0, len(ve.Earnings))
+	for _, e := range ve.Earnings {
+		earnings = append(earnings, e.String())
+	}
+	return fmt.Sprintf("Address:\t\t%s\nPending Relay Reward:\t%s\nPending Burn:\t\t%s\nEarnings:\n%s",
+		ve.Address, ve.PendingRelayReward, ve.PendingBurn, strings.Join(earnings, "\n\n"))
+}
@@ -27,12 +27,57 @@ const (
 	CodeNoChains              CodeType          = 115
 	CodeNoServiceURL          CodeType          = 116
 	CodeWaitingValidator      CodeType          = 117
+	CodeStakeDecrease         CodeType          = 118
+	CodeUnauthorizedSigner    CodeType          = 119
+	CodeNoDelegation          CodeType          = 120
+	CodeNotEnoughShares       CodeType          = 121
+	CodeInvalidCommission     CodeType          = 122
+	CodeSelfDelegation        CodeType          = 123
+	CodeInvalidRedelegation   CodeType          = 124
+	CodeInvalidExchangeRate   CodeType          = 125
+	CodeUnstakeBelowMinimum   CodeType          = 126
 )
 
 func ErrValidatorWaitingToUnstake(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeWaitingValidator, "validator is currently waiting to unstake")
 }
 
+func ErrStakeDecrease(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeStakeDecrease, "validator may not decrease the stake when editing, must begin unstaking")
+}
+
+func ErrUnauthorizedSigner(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorizedSigner, "the signer is not authorized, only the output address may unstake or change the output address")
+}
+
+func ErrNoDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoDelegation, "no delegation found for the delegator and validator")
+}
+
+func ErrNotEnoughShares(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNotEnoughShares, "the delegation does not hold enough shares for the amount")
+}
+
+func ErrInvalidCommission(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidCommission, "the commission rate must be between 0 and 1")
+}
+
+func ErrSelfDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSelfDelegation, "a validator may not delegate to itself, must edit the stake")
+}
+
+func ErrInvalidRedelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidRedelegation, "the source and destination validators of a redelegation must differ")
+}
+
+func ErrInvalidExchangeRate(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidExchangeRate, "the validator has delegator shares but no delegated tokens")
+}
+
+func ErrUnstakeBelowMinimum(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnstakeBelowMinimum, "the partial unstake would leave the validator below the minimum stake, must begin unstaking")
+}
+
 func ErrNoServiceURL(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeNoServiceURL, "validator must stake with a serviceurl")
 }
@@ -464,3 +464,183 @@ func TestErrValidatorWaitingToUnstake(t *testing.T) {
 		})
 	}
 }
+
+func TestErrStakeDecrease(t *testing.T) {
+	type args struct {
+		codespace types.CodespaceType
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Error
+	}{
+		{"Stake Decrease", args{codespace: codespace}, types.NewError(codespace, CodeStakeDecrease, "validator may not decrease the stake when editing, must begin unstaking")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrStakeDecrease(tt.args.codespace); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ErrStakeDecrease() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrUnauthorizedSigner(t *testing.T) {
+	type args struct {
+		codespace types.CodespaceType
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Error
+	}{
+		{"Unauthorized Signer", args{codespace: codespace}, types.NewError(codespace, CodeUnauthorizedSigner, "the signer is not authorized, only the output address may unstake or change the output address")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrUnauthorizedSigner(tt.args.codespace); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ErrUnauthorizedSigner() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrNoDelegation(t *testing.T) {
+	type args struct {
+		codespace types.CodespaceType
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Error
+	}{
+		{"No Delegation", args{codespace: codespace}, types.NewError(codespace, CodeNoDelegation, "no delegation found for the delegator and validator")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrNoDelegation(tt.args.codespace); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ErrNoDelegation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrNotEnoughShares(t *testing.T) {
+	type args struct {
+		codespace types.CodespaceType
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Error
+	}{
+		{"Not Enough Shares", args{codespace: codespace}, types.NewError(codespace, CodeNotEnoughShares, "the delegation does not hold enough shares for the amount")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrNotEnoughShares(tt.args.codespace); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ErrNotEnoughShares() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrInvalidCommission(t *testing.T) {
+	type args struct {
+		codespace types.CodespaceType
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Error
+	}{
+		{"Invalid Commission", args{codespace: codespace}, types.NewError(codespace, CodeInvalidCommission, "the commission rate must be between 0 and 1")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrInvalidCommission(tt.args.codespace); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ErrInvalidCommission() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrSelfDelegation(t *testing.T) {
+	type args struct {
+		codespace types.CodespaceType
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Error
+	}{
+		{"Self Delegation", args{codespace: codespace}, types.NewError(codespace, CodeSelfDelegation, "a validator may not delegate to itself, must edit the stake")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrSelfDelegation(tt.args.codespace); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ErrSelfDelegation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrInvalidRedelegation(t *testing.T) {
+	type args struct {
+		codespace types.CodespaceType
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Error
+	}{
+		{"Invalid Redelegation", args{codespace: codespace}, types.NewError(codespace, CodeInvalidRedelegation, "the source and destination validators of a redelegation must differ")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrInvalidRedelegation(tt.args.codespace); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ErrInvalidRedelegation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrInvalidExchangeRate(t *testing.T) {
+	type args struct {
+		codespace types.CodespaceType
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Error
+	}{
+		{"Invalid Exchange Rate", args{codespace: codespace}, types.NewError(codespace, CodeInvalidExchangeRate, "the validator has delegator shares but no delegated tokens")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrInvalidExchangeRate(tt.args.codespace); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ErrInvalidExchangeRate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrUnstakeBelowMinimum(t *testing.T) {
+	type args struct {
+		codespace types.CodespaceType
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.Error
+	}{
+		{"Unstake Below Minimum", args{codespace: codespace}, types.NewError(codespace, CodeUnstakeBelowMinimum, "the partial unstake would leave the validator below the minimum stake, must begin unstaking")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ErrUnstakeBelowMinimum(tt.args.codespace); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ErrUnstakeBelowMinimum() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
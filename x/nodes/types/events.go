@@ -12,6 +12,19 @@ const (
 	EventTypeDAOAllocation           = "dao_allocation"
 	EventTypeSlash                   = "slash"
 	EventTypeLiveness                = "liveness"
+	EventTypeDelegate                = "delegate"
+	EventTypeUndelegate              = "undelegate"
+	EventTypeRedelegate              = "redelegate"
+	EventTypeCompleteUndelegation    = "complete_undelegation"
+	EventTypeSetCommission           = "set_commission"
+	EventTypeDelegatorReward         = "delegator_reward"
+	EventTypePartialUnstake          = "partial_unstake"
+	EventTypeCompletePartialUnstake  = "complete_partial_unstake"
+	EventTypeRotateConsensusKey      = "rotate_consensus_key"
+	AttributeKeyDelegator            = "delegator"
+	AttributeKeySrcValidator         = "source_validator"
+	AttributeKeyDstValidator         = "destination_validator"
+	AttributeKeyCommission           = "commission"
 	AttributeKeyAddress              = "address"
 	AttributeKeyHeight               = "height"
 	AttributeKeyPower                = "power"
@@ -21,5 +34,7 @@ const (
 	AttributeValueDoubleSign         = "double_sign"
 	AttributeValueMissingSignature   = "missing_signature"
 	AttributeKeyValidator            = "validator"
+	AttributeKeyConsensusKey         = "consensus_key"
+	AttributeKeyCompletionTime       = "completion_time"
 	AttributeValueCategory           = ModuleName
 )
@@ -14,6 +14,9 @@ type GenesisState struct {
 	SigningInfos             map[string]ValidatorSigningInfo `json:"signing_infos" yaml:"signing_infos"`
 	MissedBlocks             map[string][]MissedBlock        `json:"missed_blocks" yaml:"missed_blocks"`
 	PreviousProposer         sdk.Address                     `json:"previous_proposer" yaml:"previous_proposer"`
+	Delegations              []Delegation                    `json:"delegations" yaml:"delegations"`
+	UnstakingDelegations     []UnstakingDelegation           `json:"unstaking_delegations" yaml:"unstaking_delegations"`
+	PartialUnstakes          []PartialUnstake                `json:"partial_unstakes" yaml:"partial_unstakes"`
 }
 
 // PrevState validator power, needed for validator set update logic
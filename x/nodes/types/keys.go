@@ -19,20 +19,42 @@ var ( // Keys for store prefixes
 	ProposerKey                     = []byte{0x01} // key for the proposer address used for rewards
 	ValidatorSigningInfoKey         = []byte{0x11} // Prefix for signing info used in slashing
 	ValidatorMissedBlockBitArrayKey = []byte{0x12} // Prefix for missed block bit array used in slashing
+	ConsensusAddressKey             = []byte{0x13} // prefix for each key to the validator address of a rotated consensus key
 	AllValidatorsKey                = []byte{0x21} // prefix for each key to a validator
 	StakedValidatorsKey             = []byte{0x23} // prefix for each key to a staked validator index, sorted by power
+	StakedValidatorsByChainKey      = []byte{0x24} // prefix for each key to a staked validator index, grouped by chain
+	ChainIndexBuiltKey              = []byte{0x25} // key for the marker of a chain index built from the staked validators
 	PrevStateValidatorsPowerKey     = []byte{0x31} // prefix for the key to the validators of the prevState state
 	PrevStateTotalPowerKey          = []byte{0x32} // prefix for the total power of the prevState state
+	PrevStateConsensusKeyKey        = []byte{0x33} // prefix for the consensus key of the prevState state replaced by a rotation
 	UnstakingValidatorsKey          = []byte{0x41} // prefix for unstaking validator
 	AwardValidatorKey               = []byte{0x51} // prefix for awarding validators
 	BurnValidatorKey                = []byte{0x52} // prefix for awarding validators
+	ChallengeBurnValidatorKey       = []byte{0x53} // prefix for burning validators for challenges
 	WaitingToBeginUnstakingKey      = []byte{0x43} // prefix for waiting validators
+	PartialUnstakingKey             = []byte{0x44} // prefix for each key to a partial unstake, sorted by completion time
+	DelegationKey                   = []byte{0x61} // prefix for each key to a delegation, grouped by validator
+	UnstakingDelegationKey          = []byte{0x62} // prefix for each key to an unstaking delegation, sorted by completion time
+	DelegationByDelegatorKey        = []byte{0x63} // prefix for each key to a delegation index, grouped by delegator
+	SlashRecordKey                  = []byte{0x71} // prefix for each key to a slash record, grouped by validator and sorted by height
+	EarningKey                      = []byte{0x72} // prefix for each key to an earning, grouped by validator and sorted by height
+	EarningByHeightKey              = []byte{0x73} // prefix for each key to an earning index, sorted by height (used for pruning)
 )
 
 func KeyForValWaitingToBeginUnstaking(addr sdk.Address) []byte {
 	return append(WaitingToBeginUnstakingKey, addr.Bytes()...)
 }
 
+// generates the key for the validator address of a rotated consensus key
+func KeyForConsensusAddress(consAddr sdk.Address) []byte {
+	return append(ConsensusAddressKey, consAddr.Bytes()...)
+}
+
+// generates the key for the prevState consensus key of a validator that rotated its key
+func KeyForPrevStateConsensusKey(addr sdk.Address) []byte {
+	return append(PrevStateConsensusKeyKey, addr.Bytes()...)
+}
+
 // generates the key for the validator with address
 func KeyForValByAllVals(addr sdk.Address) []byte {
 	return append(AllValidatorsKey, addr.Bytes()...)
@@ -44,12 +66,68 @@ func KeyForUnstakingValidators(unstakingTime time.Time) []byte {
 	return append(UnstakingValidatorsKey, bz...) // use the unstaking time as part of the key
 }
 
+// generates the key prefix for the partial unstakes completing at the completion time
+func KeyForPartialUnstakes(completionTime time.Time) []byte {
+	bz := sdk.FormatTimeBytes(completionTime)
+	return append(append([]byte{}, PartialUnstakingKey...), bz...)
+}
+
+// generates the key for the partial unstake of a validator
+func KeyForPartialUnstake(completionTime time.Time, addr sdk.Address) []byte {
+	return append(KeyForPartialUnstakes(completionTime), addr.Bytes()...)
+}
+
 // generates the key for a validator in the staking set
 func KeyForValidatorInStakingSet(validator Validator) []byte {
 	// NOTE the address doesn't need to be stored because counter bytes must always be different
 	return getStakedValPowerRankKey(validator)
 }
 
+// generates the key prefix for all staked validators of a chain
+// NOTE the chain is length prefixed so one chain can never be a prefix of another
+func KeyForValidatorsByChain(chain string) []byte {
+	chainLen := make([]byte, 2)
+	binary.BigEndian.PutUint16(chainLen, uint16(len(chain)))
+	key := append(append([]byte{}, StakedValidatorsByChainKey...), chainLen...)
+	return append(key, []byte(chain)...)
+}
+
+// generates the key for a staked validator in the chain index
+func KeyForValidatorByChain(chain string, addr sdk.Address) []byte {
+	return append(KeyForValidatorsByChain(chain), addr.Bytes()...)
+}
+
+// generates the key prefix for all delegations to a validator
+func KeyForDelegationsByValidator(validator sdk.Address) []byte {
+	return append(append([]byte{}, DelegationKey...), validator.Bytes()...)
+}
+
+// generates the key for the delegation of delegator to validator
+func KeyForDelegation(validator, delegator sdk.Address) []byte {
+	return append(KeyForDelegationsByValidator(validator), delegator.Bytes()...)
+}
+
+// generates the key prefix for the delegation index of all delegations of a delegator
+func KeyForDelegationsByDelegator(delegator sdk.Address) []byte {
+	return append(append([]byte{}, DelegationByDelegatorKey...), delegator.Bytes()...)
+}
+
+// generates the key for the delegation index of the delegation of delegator to validator
+func KeyForDelegationByDelegator(delegator, validator sdk.Address) []byte {
+	return append(KeyForDelegationsByDelegator(delegator), validator.Bytes()...)
+}
+
+// generates the key prefix for the unstaking delegations completing at the completion time
+func KeyForUnstakingDelegations(completionTime time.Time) []byte {
+	bz := sdk.FormatTimeBytes(completionTime)
+	return append(append([]byte{}, UnstakingDelegationKey...), bz...)
+}
+
+// generates the key for an unstaking delegation
+func KeyForUnstakingDelegation(completionTime time.Time, delegator, validator sdk.Address) []byte {
+	return append(append(KeyForUnstakingDelegations(completionTime), delegator.Bytes()...), validator.Bytes()...)
+}
+
 // generates the key for a validator in the prevState state
 func KeyForValidatorPrevStateStateByPower(address sdk.Address) []byte {
 	return append(PrevStateValidatorsPowerKey, address...)
@@ -64,6 +142,53 @@ func KeyForValidatorBurn(address sdk.Address) []byte {
 	return append(BurnValidatorKey, address...)
 }
 
+// generates the challenge burn key for a validator in the current state
+func KeyForValidatorChallengeBurn(address sdk.Address) []byte {
+	return append(ChallengeBurnValidatorKey, address...)
+}
+
+// generates the key prefix for the slash records of a validator
+func KeyForSlashRecords(address sdk.Address) []byte {
+	return append(append([]byte{}, SlashRecordKey...), address.Bytes()...)
+}
+
+// generates the key prefix for the slash records of a validator at a height
+func KeyForSlashRecordsAtHeight(address sdk.Address, height int64) []byte {
+	return append(KeyForSlashRecords(address), heightBytes(height)...)
+}
+
+// generates the key for a slash record of a validator at a height, the sequence keeps the records of a height in order
+func KeyForSlashRecord(address sdk.Address, height, sequence int64, reason string) []byte {
+	return append(append(KeyForSlashRecordsAtHeight(address, height), heightBytes(sequence)...), []byte(reason)...)
+}
+
+// generates the key prefix for the earnings of a validator
+func KeyForEarnings(address sdk.Address) []byte {
+	return append(append([]byte{}, EarningKey...), address.Bytes()...)
+}
+
+// generates the key for an earning of a validator at a height
+func KeyForEarning(address sdk.Address, height int64, earningType string) []byte {
+	return append(append(KeyForEarnings(address), heightBytes(height)...), []byte(earningType)...)
+}
+
+// generates the key prefix for the earnings index at a height
+func KeyForEarningsByHeight(height int64) []byte {
+	return append(append([]byte{}, EarningByHeightKey...), heightBytes(height)...)
+}
+
+// generates the key for the index of an earning of a validator at a height
+func KeyForEarningByHeight(height int64, address sdk.Address, earningType string) []byte {
+	return append(append(KeyForEarningsByHeight(height), address.Bytes()...), []byte(earningType)...)
+}
+
+// returns the big endian bytes of a height so the keys are sorted by height
+func heightBytes(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return bz
+}
+
 // Removes the prefix bytes from a key to expose true address
 func AddressFromKey(key []byte) []byte {
 	return key[1:] // remove prefix bytes
@@ -212,6 +212,29 @@ func TestKeyForValidatorBurn(t *testing.T) {
 	}
 }
 
+func TestKeyForValidatorByChain(t *testing.T) {
+	type args struct {
+		chain   string
+		address types.Address
+	}
+	ca, _ := types.AddressFromHex("29f0a60104f3218a2cb51e6a269182d5dc271447114e342086d9c922a106a3c0")
+
+	tests := []struct {
+		name string
+		args args
+		want []byte
+	}{
+		{"sampleByteArray", args{"ab", ca}, append([]byte{0x24, 0x00, 0x02, 'a', 'b'}, ca.Bytes()...)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := KeyForValidatorByChain(tt.args.chain, tt.args.address); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("KeyForValidatorByChain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestKeyForValidatorInStakingSet(t *testing.T) {
 	type args struct {
 		validator Validator
@@ -347,3 +370,122 @@ func TestKeyForValWaitingToBeginUnstaking(t *testing.T) {
 		})
 	}
 }
+
+func TestKeyForDelegation(t *testing.T) {
+	var pub, delegatorPub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	rand.Read(delegatorPub[:])
+	va := types.Address(pub.Address())
+	da := types.Address(delegatorPub.Address())
+
+	want := append(append([]byte{0x61}, va.Bytes()...), da.Bytes()...)
+	if got := KeyForDelegation(va, da); !reflect.DeepEqual(got, want) {
+		t.Errorf("KeyForDelegation() = %v, want %v", got, want)
+	}
+	// the delegations of a validator share its prefix
+	if got := KeyForDelegationsByValidator(va); !reflect.DeepEqual(got, want[:1+len(va)]) {
+		t.Errorf("KeyForDelegationsByValidator() = %v, want %v", got, want[:1+len(va)])
+	}
+}
+
+func TestKeyForUnstakingDelegation(t *testing.T) {
+	var pub, delegatorPub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	rand.Read(delegatorPub[:])
+	va := types.Address(pub.Address())
+	da := types.Address(delegatorPub.Address())
+	ut := time.Now()
+
+	prefix := append([]byte{0x62}, types.FormatTimeBytes(ut)...)
+	if got := KeyForUnstakingDelegations(ut); !reflect.DeepEqual(got, prefix) {
+		t.Errorf("KeyForUnstakingDelegations() = %v, want %v", got, prefix)
+	}
+	want := append(append(append([]byte{}, prefix...), da.Bytes()...), va.Bytes()...)
+	if got := KeyForUnstakingDelegation(ut, da, va); !reflect.DeepEqual(got, want) {
+		t.Errorf("KeyForUnstakingDelegation() = %v, want %v", got, want)
+	}
+}
+
+func TestKeyForPartialUnstake(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	va := types.Address(pub.Address())
+	ut := time.Now()
+
+	prefix := append([]byte{0x44}, types.FormatTimeBytes(ut)...)
+	if got := KeyForPartialUnstakes(ut); !reflect.DeepEqual(got, prefix) {
+		t.Errorf("KeyForPartialUnstakes() = %v, want %v", got, prefix)
+	}
+	want := append(append([]byte{}, prefix...), va.Bytes()...)
+	if got := KeyForPartialUnstake(ut, va); !reflect.DeepEqual(got, want) {
+		t.Errorf("KeyForPartialUnstake() = %v, want %v", got, want)
+	}
+}
+
+func TestKeyForConsensusAddress(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	ca := types.Address(pub.Address())
+
+	want := append([]byte{0x13}, ca.Bytes()...)
+	if got := KeyForConsensusAddress(ca); !reflect.DeepEqual(got, want) {
+		t.Errorf("KeyForConsensusAddress() = %v, want %v", got, want)
+	}
+}
+
+func TestKeyForPrevStateConsensusKey(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	va := types.Address(pub.Address())
+
+	want := append([]byte{0x33}, va.Bytes()...)
+	if got := KeyForPrevStateConsensusKey(va); !reflect.DeepEqual(got, want) {
+		t.Errorf("KeyForPrevStateConsensusKey() = %v, want %v", got, want)
+	}
+	if got := AddressFromKey(want); !reflect.DeepEqual(types.Address(got), va) {
+		t.Errorf("AddressFromKey() = %v, want %v", got, va)
+	}
+}
+
+func TestKeyForSlashRecord(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	va := types.Address(pub.Address())
+
+	prefix := append([]byte{0x71}, va.Bytes()...)
+	if got := KeyForSlashRecords(va); !reflect.DeepEqual(got, prefix) {
+		t.Errorf("KeyForSlashRecords() = %v, want %v", got, prefix)
+	}
+	atHeight := append(append([]byte{}, prefix...), 0, 0, 0, 0, 0, 0, 1, 2)
+	if got := KeyForSlashRecordsAtHeight(va, 258); !reflect.DeepEqual(got, atHeight) {
+		t.Errorf("KeyForSlashRecordsAtHeight() = %v, want %v", got, atHeight)
+	}
+	want := append(append(append([]byte{}, atHeight...), 0, 0, 0, 0, 0, 0, 0, 3), []byte(SlashReasonDowntime)...)
+	if got := KeyForSlashRecord(va, 258, 3, SlashReasonDowntime); !reflect.DeepEqual(got, want) {
+		t.Errorf("KeyForSlashRecord() = %v, want %v", got, want)
+	}
+}
+
+func TestKeyForEarning(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	va := types.Address(pub.Address())
+	height := []byte{0, 0, 0, 0, 0, 0, 1, 2}
+
+	prefix := append([]byte{0x72}, va.Bytes()...)
+	if got := KeyForEarnings(va); !reflect.DeepEqual(got, prefix) {
+		t.Errorf("KeyForEarnings() = %v, want %v", got, prefix)
+	}
+	want := append(append(append([]byte{}, prefix...), height...), []byte(EarningTypeRelay)...)
+	if got := KeyForEarning(va, 258, EarningTypeRelay); !reflect.DeepEqual(got, want) {
+		t.Errorf("KeyForEarning() = %v, want %v", got, want)
+	}
+	heightPrefix := append([]byte{0x73}, height...)
+	if got := KeyForEarningsByHeight(258); !reflect.DeepEqual(got, heightPrefix) {
+		t.Errorf("KeyForEarningsByHeight() = %v, want %v", got, heightPrefix)
+	}
+	want = append(append(append([]byte{}, heightPrefix...), va.Bytes()...), []byte(EarningTypeRelay)...)
+	if got := KeyForEarningByHeight(258, va, EarningTypeRelay); !reflect.DeepEqual(got, want) {
+		t.Errorf("KeyForEarningByHeight() = %v, want %v", got, want)
+	}
+}
@@ -11,26 +11,43 @@ var (
 	_ sdk.Msg = &MsgBeginUnstake{}
 	_ sdk.Msg = &MsgUnjail{}
 	_ sdk.Msg = &MsgSend{}
+	_ sdk.Msg = &MsgDelegate{}
+	_ sdk.Msg = &MsgUndelegate{}
+	_ sdk.Msg = &MsgRedelegate{}
+	_ sdk.Msg = &MsgSetCommission{}
+	_ sdk.Msg = &MsgPartialUnstake{}
+	_ sdk.Msg = &MsgRotateConsensusKey{}
 )
 
 const (
-	MsgStakeName   = "stake_validator"
-	MsgUnstakeName = "begin_unstake_validator"
-	MsgUnjailName  = "unjail_validator"
-	MsgSendName    = "send"
+	MsgStakeName              = "stake_validator"
+	MsgUnstakeName            = "begin_unstake_validator"
+	MsgUnjailName             = "unjail_validator"
+	MsgSendName               = "send"
+	MsgDelegateName           = "delegate"
+	MsgUndelegateName         = "undelegate"
+	MsgRedelegateName         = "redelegate"
+	MsgSetCommissionName      = "set_commission"
+	MsgPartialUnstakeName     = "partial_unstake_validator"
+	MsgRotateConsensusKeyName = "rotate_consensus_key"
 )
 
 //----------------------------------------------------------------------------------------------------------------------
 // MsgStake - struct for staking transactions
 type MsgStake struct {
-	PublicKey  crypto.PublicKey `json:"public_key" yaml:"public_key"`
-	Chains     []string         `json:"chains" yaml:"chains"`
-	Value      sdk.Int          `json:"value" yaml:"value"`
-	ServiceURL string           `json:"service_url" yaml:"service_url"`
+	PublicKey     crypto.PublicKey `json:"public_key" yaml:"public_key"`
+	Chains        []string         `json:"chains" yaml:"chains"`
+	Value         sdk.Int          `json:"value" yaml:"value"`
+	ServiceURL    string           `json:"service_url" yaml:"service_url"`
+	OutputAddress sdk.Address      `json:"output_address,omitempty" yaml:"output_address"` // optional address that receives the rewards and unstaked tokens
+	Signer        sdk.Address      `json:"signer,omitempty" yaml:"signer"`                 // optional signer, the output address when it changes the output
 }
 
 // Return address(es) that must sign over msg.GetSignBytes()
 func (msg MsgStake) GetSigners() []sdk.Address {
+	if !msg.Signer.Empty() {
+		return []sdk.Address{msg.Signer}
+	}
 	addrs := []sdk.Address{sdk.Address(msg.PublicKey.Address())}
 	return addrs
 }
@@ -70,10 +87,14 @@ func (msg MsgStake) Type() string  { return MsgStakeName }
 // MsgBeginUnstake - struct for unstaking transaciton
 type MsgBeginUnstake struct {
 	Address sdk.Address `json:"validator_address" yaml:"validator_address"`
+	Signer  sdk.Address `json:"signer,omitempty" yaml:"signer"` // optional signer, the output address of the validator if set
 }
 
 // Return address(es) that must sign over msg.GetSignBytes()
 func (msg MsgBeginUnstake) GetSigners() []sdk.Address {
+	if !msg.Signer.Empty() {
+		return []sdk.Address{msg.Signer}
+	}
 	return []sdk.Address{msg.Address}
 }
 
@@ -94,6 +115,78 @@ func (msg MsgBeginUnstake) ValidateBasic() sdk.Error {
 func (msg MsgBeginUnstake) Route() string { return RouterKey }
 func (msg MsgBeginUnstake) Type() string  { return MsgUnstakeName }
 
+//----------------------------------------------------------------------------------------------------------------------
+// MsgPartialUnstake - struct for unstaking part of the stake while staying staked
+type MsgPartialUnstake struct {
+	Address sdk.Address `json:"validator_address" yaml:"validator_address"`
+	Amount  sdk.Int     `json:"amount" yaml:"amount"`
+	Signer  sdk.Address `json:"signer,omitempty" yaml:"signer"` // optional signer, the output address of the validator if set
+}
+
+// Return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgPartialUnstake) GetSigners() []sdk.Address {
+	if !msg.Signer.Empty() {
+		return []sdk.Address{msg.Signer}
+	}
+	return []sdk.Address{msg.Address}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgPartialUnstake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Quick validity check, stateless
+func (msg MsgPartialUnstake) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadDelegationAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+func (msg MsgPartialUnstake) Route() string { return RouterKey }
+func (msg MsgPartialUnstake) Type() string  { return MsgPartialUnstakeName }
+
+//----------------------------------------------------------------------------------------------------------------------
+// MsgRotateConsensusKey - struct for replacing the consensus key of a validator, keeping its address and stake
+type MsgRotateConsensusKey struct {
+	Address   sdk.Address      `json:"validator_address" yaml:"validator_address"`
+	PublicKey crypto.PublicKey `json:"public_key" yaml:"public_key"`   // the new consensus public key
+	Signer    sdk.Address      `json:"signer,omitempty" yaml:"signer"` // optional signer, the output address of the validator if set
+}
+
+// Return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgRotateConsensusKey) GetSigners() []sdk.Address {
+	if !msg.Signer.Empty() {
+		return []sdk.Address{msg.Signer}
+	}
+	return []sdk.Address{msg.Address}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRotateConsensusKey) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Quick validity check, stateless
+func (msg MsgRotateConsensusKey) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.PublicKey == nil || msg.PublicKey.RawString() == "" {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	return nil
+}
+
+func (msg MsgRotateConsensusKey) Route() string { return RouterKey }
+func (msg MsgRotateConsensusKey) Type() string  { return MsgRotateConsensusKeyName }
+
 //----------------------------------------------------------------------------------------------------------------------
 // MsgUnjail - struct for unjailing jailed validator
 type MsgUnjail struct {
@@ -157,3 +250,148 @@ func (msg MsgSend) ValidateBasic() sdk.Error {
 
 func (msg MsgSend) Route() string { return RouterKey }
 func (msg MsgSend) Type() string  { return MsgSendName }
+
+//----------------------------------------------------------------------------------------------------------------------
+// MsgDelegate - struct for delegating tokens to a validator
+type MsgDelegate struct {
+	DelegatorAddress sdk.Address `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.Address `json:"validator_address" yaml:"validator_address"`
+	Amount           sdk.Int     `json:"amount" yaml:"amount"`
+}
+
+// Return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgDelegate) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.DelegatorAddress}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgDelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Quick validity check, stateless
+func (msg MsgDelegate) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() || msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.DelegatorAddress.Equals(msg.ValidatorAddress) {
+		return ErrSelfDelegation(DefaultCodespace)
+	}
+	if msg.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadDelegationAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+func (msg MsgDelegate) Route() string { return RouterKey }
+func (msg MsgDelegate) Type() string  { return MsgDelegateName }
+
+//----------------------------------------------------------------------------------------------------------------------
+// MsgUndelegate - struct for returning delegated tokens after the unstaking time
+type MsgUndelegate struct {
+	DelegatorAddress sdk.Address `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.Address `json:"validator_address" yaml:"validator_address"`
+	Amount           sdk.Int     `json:"amount" yaml:"amount"`
+}
+
+// Return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgUndelegate) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.DelegatorAddress}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgUndelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Quick validity check, stateless
+func (msg MsgUndelegate) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() || msg.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadDelegationAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+func (msg MsgUndelegate) Route() string { return RouterKey }
+func (msg MsgUndelegate) Type() string  { return MsgUndelegateName }
+
+//----------------------------------------------------------------------------------------------------------------------
+// MsgRedelegate - struct for moving delegated tokens from one validator to another
+type MsgRedelegate struct {
+	DelegatorAddress    sdk.Address `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorSrcAddress sdk.Address `json:"validator_src_address" yaml:"validator_src_address"`
+	ValidatorDstAddress sdk.Address `json:"validator_dst_address" yaml:"validator_dst_address"`
+	Amount              sdk.Int     `json:"amount" yaml:"amount"`
+}
+
+// Return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgRedelegate) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.DelegatorAddress}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRedelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Quick validity check, stateless
+func (msg MsgRedelegate) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddress.Empty() || msg.ValidatorSrcAddress.Empty() || msg.ValidatorDstAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorSrcAddress.Equals(msg.ValidatorDstAddress) {
+		return ErrInvalidRedelegation(DefaultCodespace)
+	}
+	if msg.DelegatorAddress.Equals(msg.ValidatorDstAddress) {
+		return ErrSelfDelegation(DefaultCodespace)
+	}
+	if msg.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadDelegationAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+func (msg MsgRedelegate) Route() string { return RouterKey }
+func (msg MsgRedelegate) Type() string  { return MsgRedelegateName }
+
+//----------------------------------------------------------------------------------------------------------------------
+// MsgSetCommission - struct for setting the commission rate a validator keeps of the delegators relay rewards
+type MsgSetCommission struct {
+	Address    sdk.Address `json:"validator_address" yaml:"validator_address"`
+	Commission sdk.Dec     `json:"commission" yaml:"commission"`
+	Signer     sdk.Address `json:"signer,omitempty" yaml:"signer"` // optional signer, the output address of the validator if set
+}
+
+// Return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgSetCommission) GetSigners() []sdk.Address {
+	if !msg.Signer.Empty() {
+		return []sdk.Address{msg.Signer}
+	}
+	return []sdk.Address{msg.Address}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgSetCommission) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Quick validity check, stateless
+func (msg MsgSetCommission) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.Commission.IsNil() || msg.Commission.IsNegative() || msg.Commission.GT(sdk.OneDec()) {
+		return ErrInvalidCommission(DefaultCodespace)
+	}
+	return nil
+}
+
+func (msg MsgSetCommission) Route() string { return RouterKey }
+func (msg MsgSetCommission) Type() string  { return MsgSetCommissionName }
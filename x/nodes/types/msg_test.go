@@ -42,11 +42,15 @@ func TestMsgBeginUnstake_GetSignBytes(t *testing.T) {
 func TestMsgBeginUnstake_GetSigners(t *testing.T) {
 	type fields struct {
 		Address sdk.Address
+		Signer  sdk.Address
 	}
 
 	var pub crypto.Ed25519PublicKey
 	rand.Read(pub[:])
 	va := sdk.Address(pub.Address())
+	var outputPub crypto.Ed25519PublicKey
+	rand.Read(outputPub[:])
+	output := sdk.Address(outputPub.Address())
 
 	mesg := MsgBeginUnstake{
 		Address: va,
@@ -57,12 +61,14 @@ func TestMsgBeginUnstake_GetSigners(t *testing.T) {
 		fields fields
 		want   []sdk.Address
 	}{
-		{"Test GetSigners", fields{va}, []sdk.Address{sdk.Address(mesg.Address)}},
+		{"Test GetSigners", fields{va, nil}, []sdk.Address{sdk.Address(mesg.Address)}},
+		{"Test GetSigners With Signer", fields{va, output}, []sdk.Address{output}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			msg := MsgBeginUnstake{
 				Address: tt.fields.Address,
+				Signer:  tt.fields.Signer,
 			}
 			if got := msg.GetSigners(); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("GetSigners() = %v, want %v", got, tt.want)
@@ -423,10 +429,14 @@ func TestMsgStake_GetSigners(t *testing.T) {
 		Chains     []string
 		Value      sdk.Int
 		ServiceURL string
+		Signer     sdk.Address
 	}
 
 	var pub crypto.Ed25519PublicKey
 	rand.Read(pub[:])
+	var outputPub crypto.Ed25519PublicKey
+	rand.Read(outputPub[:])
+	output := sdk.Address(outputPub.Address())
 	chains := []string{"b60d7bdd334cd3768d43f14a05c7fe7e886ba5bcb77e1064530052fed1a3f145"}
 	value := sdk.OneInt()
 	surl := "www.pokt.network"
@@ -442,6 +452,13 @@ func TestMsgStake_GetSigners(t *testing.T) {
 			Value:      value,
 			ServiceURL: surl,
 		}, []sdk.Address{sdk.Address(pub.Address())}},
+		{"Test GetSigners With Signer", fields{
+			PubKey:     pub,
+			Chains:     chains,
+			Value:      value,
+			ServiceURL: surl,
+			Signer:     output,
+		}, []sdk.Address{output}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -450,6 +467,7 @@ func TestMsgStake_GetSigners(t *testing.T) {
 				Chains:     tt.fields.Chains,
 				Value:      tt.fields.Value,
 				ServiceURL: tt.fields.ServiceURL,
+				Signer:     tt.fields.Signer,
 			}
 			if got := msg.GetSigners(); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("GetSigners() = %v, want %v", got, tt.want)
@@ -752,3 +770,181 @@ func TestMsgUnjail_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgDelegate_ValidateBasic(t *testing.T) {
+	var pub, delegatorPub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	rand.Read(delegatorPub[:])
+	va := sdk.Address(pub.Address())
+	da := sdk.Address(delegatorPub.Address())
+
+	tests := []struct {
+		name string
+		msg  MsgDelegate
+		want sdk.Error
+	}{
+		{"Test ValidateBasic OK", MsgDelegate{da, va, sdk.NewInt(1)}, nil},
+		{"Test ValidateBasic Missing Address", MsgDelegate{nil, va, sdk.NewInt(1)}, ErrNilValidatorAddr(DefaultCodespace)},
+		{"Test ValidateBasic Self Delegation", MsgDelegate{va, va, sdk.NewInt(1)}, ErrSelfDelegation(DefaultCodespace)},
+		{"Test ValidateBasic Zero Amount", MsgDelegate{da, va, sdk.ZeroInt()}, ErrBadDelegationAmount(DefaultCodespace)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+			if got := tt.msg.GetSigners(); !reflect.DeepEqual(got, []sdk.Address{tt.msg.DelegatorAddress}) {
+				t.Errorf("GetSigners() = %v, want %v", got, []sdk.Address{tt.msg.DelegatorAddress})
+			}
+		})
+	}
+}
+
+func TestMsgUndelegate_ValidateBasic(t *testing.T) {
+	var pub, delegatorPub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	rand.Read(delegatorPub[:])
+	va := sdk.Address(pub.Address())
+	da := sdk.Address(delegatorPub.Address())
+
+	tests := []struct {
+		name string
+		msg  MsgUndelegate
+		want sdk.Error
+	}{
+		{"Test ValidateBasic OK", MsgUndelegate{da, va, sdk.NewInt(1)}, nil},
+		{"Test ValidateBasic Missing Address", MsgUndelegate{da, nil, sdk.NewInt(1)}, ErrNilValidatorAddr(DefaultCodespace)},
+		{"Test ValidateBasic Zero Amount", MsgUndelegate{da, va, sdk.ZeroInt()}, ErrBadDelegationAmount(DefaultCodespace)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+			if got := tt.msg.GetSigners(); !reflect.DeepEqual(got, []sdk.Address{tt.msg.DelegatorAddress}) {
+				t.Errorf("GetSigners() = %v, want %v", got, []sdk.Address{tt.msg.DelegatorAddress})
+			}
+		})
+	}
+}
+
+func TestMsgRedelegate_ValidateBasic(t *testing.T) {
+	var srcPub, dstPub, delegatorPub crypto.Ed25519PublicKey
+	rand.Read(srcPub[:])
+	rand.Read(dstPub[:])
+	rand.Read(delegatorPub[:])
+	src := sdk.Address(srcPub.Address())
+	dst := sdk.Address(dstPub.Address())
+	da := sdk.Address(delegatorPub.Address())
+
+	tests := []struct {
+		name string
+		msg  MsgRedelegate
+		want sdk.Error
+	}{
+		{"Test ValidateBasic OK", MsgRedelegate{da, src, dst, sdk.NewInt(1)}, nil},
+		{"Test ValidateBasic Missing Address", MsgRedelegate{da, src, nil, sdk.NewInt(1)}, ErrNilValidatorAddr(DefaultCodespace)},
+		{"Test ValidateBasic Same Validator", MsgRedelegate{da, src, src, sdk.NewInt(1)}, ErrInvalidRedelegation(DefaultCodespace)},
+		{"Test ValidateBasic Self Delegation", MsgRedelegate{dst, src, dst, sdk.NewInt(1)}, ErrSelfDelegation(DefaultCodespace)},
+		{"Test ValidateBasic Zero Amount", MsgRedelegate{da, src, dst, sdk.ZeroInt()}, ErrBadDelegationAmount(DefaultCodespace)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgSetCommission_ValidateBasic(t *testing.T) {
+	var pub, outputPub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	rand.Read(outputPub[:])
+	va := sdk.Address(pub.Address())
+	output := sdk.Address(outputPub.Address())
+
+	tests := []struct {
+		name    string
+		msg     MsgSetCommission
+		want    sdk.Error
+		signers []sdk.Address
+	}{
+		{"Test ValidateBasic OK", MsgSetCommission{va, sdk.NewDecWithPrec(5, 2), nil}, nil, []sdk.Address{va}},
+		{"Test ValidateBasic OK With Signer", MsgSetCommission{va, sdk.OneDec(), output}, nil, []sdk.Address{output}},
+		{"Test ValidateBasic Missing Address", MsgSetCommission{nil, sdk.OneDec(), nil}, ErrNilValidatorAddr(DefaultCodespace), []sdk.Address{nil}},
+		{"Test ValidateBasic Negative Commission", MsgSetCommission{va, sdk.NewDec(-1), nil}, ErrInvalidCommission(DefaultCodespace), []sdk.Address{va}},
+		{"Test ValidateBasic Commission Above One", MsgSetCommission{va, sdk.NewDec(2), nil}, ErrInvalidCommission(DefaultCodespace), []sdk.Address{va}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+			if got := tt.msg.GetSigners(); !reflect.DeepEqual(got, tt.signers) {
+				t.Errorf("GetSigners() = %v, want %v", got, tt.signers)
+			}
+		})
+	}
+}
+
+func TestMsgPartialUnstake_ValidateBasic(t *testing.T) {
+	var pub, outputPub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	rand.Read(outputPub[:])
+	va := sdk.Address(pub.Address())
+	oa := sdk.Address(outputPub.Address())
+
+	tests := []struct {
+		name        string
+		msg         MsgPartialUnstake
+		want        sdk.Error
+		wantSigners []sdk.Address
+	}{
+		{"Test ValidateBasic OK", MsgPartialUnstake{va, sdk.NewInt(1), nil}, nil, []sdk.Address{va}},
+		{"Test ValidateBasic OK Output Signer", MsgPartialUnstake{va, sdk.NewInt(1), oa}, nil, []sdk.Address{oa}},
+		{"Test ValidateBasic Missing Address", MsgPartialUnstake{nil, sdk.NewInt(1), nil}, ErrNilValidatorAddr(DefaultCodespace), []sdk.Address{nil}},
+		{"Test ValidateBasic Zero Amount", MsgPartialUnstake{va, sdk.ZeroInt(), nil}, ErrBadDelegationAmount(DefaultCodespace), []sdk.Address{va}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+			if got := tt.msg.GetSigners(); !reflect.DeepEqual(got, tt.wantSigners) {
+				t.Errorf("GetSigners() = %v, want %v", got, tt.wantSigners)
+			}
+		})
+	}
+}
+
+func TestMsgRotateConsensusKey_ValidateBasic(t *testing.T) {
+	var pub, newPub, outputPub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	rand.Read(newPub[:])
+	rand.Read(outputPub[:])
+	va := sdk.Address(pub.Address())
+	oa := sdk.Address(outputPub.Address())
+
+	tests := []struct {
+		name        string
+		msg         MsgRotateConsensusKey
+		want        sdk.Error
+		wantSigners []sdk.Address
+	}{
+		{"Test ValidateBasic OK", MsgRotateConsensusKey{va, newPub, nil}, nil, []sdk.Address{va}},
+		{"Test ValidateBasic OK Output Signer", MsgRotateConsensusKey{va, newPub, oa}, nil, []sdk.Address{oa}},
+		{"Test ValidateBasic Missing Address", MsgRotateConsensusKey{nil, newPub, nil}, ErrNilValidatorAddr(DefaultCodespace), []sdk.Address{nil}},
+		{"Test ValidateBasic Missing Public Key", MsgRotateConsensusKey{va, nil, nil}, ErrNilValidatorAddr(DefaultCodespace), []sdk.Address{va}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+			if got := tt.msg.GetSigners(); !reflect.DeepEqual(got, tt.wantSigners) {
+				t.Errorf("GetSigners() = %v, want %v", got, tt.wantSigners)
+			}
+		})
+	}
+}
@@ -12,16 +12,18 @@ import (
 // POS params default values
 const (
 	// DefaultParamspace for params keeper
-	DefaultParamspace                  = ModuleName
-	DefaultUnstakingTime               = time.Hour * 24 * 7 * 3
-	DefaultMaxValidators        uint64 = 100000
-	DefaultMinStake             int64  = 1000000
-	DefaultMaxEvidenceAge              = 60 * 2 * time.Second
-	DefaultSignedBlocksWindow          = int64(100)
-	DefaultDowntimeJailDuration        = 60 * 10 * time.Second
-	DefaultSessionBlocktime            = 25
-	DefaultProposerAllocation          = 1
-	DefaultDAOAllocation               = 10
+	DefaultParamspace                     = ModuleName
+	DefaultUnstakingTime                  = time.Hour * 24 * 7 * 3
+	DefaultMaxValidators           uint64 = 100000
+	DefaultMinStake                int64  = 1000000
+	DefaultMaxEvidenceAge                 = 60 * 2 * time.Second
+	DefaultSignedBlocksWindow             = int64(100)
+	DefaultDowntimeJailDuration           = 60 * 10 * time.Second
+	DefaultSessionBlocktime               = 25
+	DefaultProposerAllocation             = 1
+	DefaultDAOAllocation                  = 10
+	DefaultDowntimeJailDecayPeriod        = int64(1000)
+	DefaultEarningsRetention              = int64(1000)
 )
 
 // nolint - Keys for parameter access
@@ -39,10 +41,14 @@ var (
 	KeySessionBlock                = []byte("SessionBlockFrequency")
 	KeyDAOAllocation               = []byte("DAOAllocation")
 	KeyProposerAllocation          = []byte("ProposerPercentage")
+	KeyDowntimeJailEscalation      = []byte("DowntimeJailEscalation")
+	KeyDowntimeJailDecayPeriod     = []byte("DowntimeJailDecayPeriod")
+	KeyEarningsRetention           = []byte("EarningsRetention")
 	DoubleSignJailEndTime          = time.Unix(253402300799, 0) // forever
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	DefaultDowntimeJailEscalation  = sdk.NewDec(2)
 )
 
 var _ sdk.ParamSet = (*Params)(nil)
@@ -63,6 +69,9 @@ type Params struct {
 	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`         // minimum amount of time node must spend in jail after missing blocks
 	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"` // the factor of which a node is slashed for a double sign
 	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`       // the factor of which a node is slashed for missing blocks
+	DowntimeJailEscalation  sdk.Dec       `json:"downtime_jail_escalation" yaml:"downtime_jail_escalation"`     // the multiplier of the jail duration and downtime slash fraction for each previous jail within the decay period
+	DowntimeJailDecayPeriod int64         `json:"downtime_jail_decay_period" yaml:"downtime_jail_decay_period"` // how many blocks without a downtime jail reset the jail count of a node
+	EarningsRetention       int64         `json:"earnings_retention" yaml:"earnings_retention"`                 // how many blocks the earnings history of the nodes is kept
 }
 
 // Implements sdk.ParamSet
@@ -81,6 +90,9 @@ func (p *Params) ParamSetPairs() sdk.ParamSetPairs {
 		{Key: KeySessionBlock, Value: &p.SessionBlockFrequency},
 		{Key: KeyDAOAllocation, Value: &p.DAOAllocation},
 		{Key: KeyProposerAllocation, Value: &p.ProposerAllocation},
+		{Key: KeyDowntimeJailEscalation, Value: &p.DowntimeJailEscalation},
+		{Key: KeyDowntimeJailDecayPeriod, Value: &p.DowntimeJailDecayPeriod},
+		{Key: KeyEarningsRetention, Value: &p.EarningsRetention},
 	}
 }
 
@@ -100,6 +112,9 @@ func DefaultParams() Params {
 		SessionBlockFrequency:   DefaultSessionBlocktime,
 		DAOAllocation:           DefaultDAOAllocation,
 		ProposerAllocation:      DefaultProposerAllocation,
+		DowntimeJailEscalation:  DefaultDowntimeJailEscalation,
+		DowntimeJailDecayPeriod: DefaultDowntimeJailDecayPeriod,
+		EarningsRetention:       DefaultEarningsRetention,
 	}
 }
 
@@ -126,6 +141,16 @@ func (p Params) Validate() error {
 	if p.ProposerAllocation+p.DAOAllocation > 100 {
 		return fmt.Errorf("the combo of proposer allocation and dao allocation mnust not be greater than 100")
 	}
+	if p.DowntimeJailEscalation.IsNil() || p.DowntimeJailEscalation.LT(sdk.OneDec()) {
+		return fmt.Errorf("the downtime jail escalation must not be less than 1")
+	}
+	// a period of zero would reset the jail count before every jail and silently disable the escalation
+	if p.DowntimeJailDecayPeriod <= 0 {
+		return fmt.Errorf("the downtime jail decay period must be positive")
+	}
+	if p.EarningsRetention < 0 {
+		return fmt.Errorf("the earnings retention must not be negative")
+	}
 	return nil
 }
 
@@ -151,7 +176,10 @@ func (p Params) String() string {
   SlashFractionDowntime:   %s
   SessionBlockFrequency    %d
   Proposer Allocation      %d
-  DAO allocation           %d`,
+  DAO allocation           %d
+  DowntimeJailEscalation:  %s
+  DowntimeJailDecayPeriod: %d
+  EarningsRetention:       %d`,
 		p.UnstakingTime,
 		p.MaxValidators,
 		p.StakeDenom,
@@ -164,7 +192,10 @@ func (p Params) String() string {
 		p.SlashFractionDowntime,
 		p.SessionBlockFrequency,
 		p.ProposerAllocation,
-		p.DAOAllocation)
+		p.DAOAllocation,
+		p.DowntimeJailEscalation,
+		p.DowntimeJailDecayPeriod,
+		p.EarningsRetention)
 }
 
 // unmarshal the current pos params value from store key or panic
@@ -30,6 +30,9 @@ func TestDefaultParams(t *testing.T) {
 				SessionBlockFrequency:   DefaultSessionBlocktime,
 				DAOAllocation:           DefaultDAOAllocation,
 				ProposerAllocation:      DefaultProposerAllocation,
+				DowntimeJailEscalation:  DefaultDowntimeJailEscalation,
+				DowntimeJailDecayPeriod: DefaultDowntimeJailDecayPeriod,
+				EarningsRetention:       DefaultEarningsRetention,
 			},
 		}}
 	for _, tt := range tests {
@@ -147,6 +150,9 @@ func TestParams_Validate(t *testing.T) {
 		DowntimeJailDuration    time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
 		SlashFractionDoubleSign types.Dec     `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
 		SlashFractionDowntime   types.Dec     `json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+		DowntimeJailEscalation  types.Dec     `json:"downtime_jail_escalation" yaml:"downtime_jail_escalation"`
+		DowntimeJailDecayPeriod int64         `json:"downtime_jail_decay_period" yaml:"downtime_jail_decay_period"`
+		EarningsRetention       int64         `json:"earnings_retention" yaml:"earnings_retention"`
 	}
 	tests := []struct {
 		name    string
@@ -266,6 +272,69 @@ func TestParams_Validate(t *testing.T) {
 			SlashFractionDoubleSign: types.ZeroDec(),
 			SlashFractionDowntime:   types.ZeroDec(),
 		}, true},
+		{"Default Validation Test / Wrong downtime jail escalation", fields{
+			UnstakingTime:           0,
+			MaxValidators:           1000,
+			StakeDenom:              "3",
+			StakeMinimum:            1000000,
+			SessionBlock:            30,
+			ProposerAllocation:      0,
+			MaxEvidenceAge:          0,
+			SignedBlocksWindow:      0,
+			MinSignedPerWindow:      types.Dec{},
+			DowntimeJailDuration:    0,
+			SlashFractionDoubleSign: types.Dec{},
+			SlashFractionDowntime:   types.Dec{},
+			DowntimeJailEscalation:  types.NewDecWithPrec(5, 1),
+		}, true},
+		{"Default Validation Test / Wrong downtime jail decay period", fields{
+			UnstakingTime:           0,
+			MaxValidators:           1000,
+			StakeDenom:              "3",
+			StakeMinimum:            1000000,
+			SessionBlock:            30,
+			ProposerAllocation:      0,
+			MaxEvidenceAge:          0,
+			SignedBlocksWindow:      0,
+			MinSignedPerWindow:      types.Dec{},
+			DowntimeJailDuration:    0,
+			SlashFractionDoubleSign: types.Dec{},
+			SlashFractionDowntime:   types.Dec{},
+			DowntimeJailEscalation:  types.OneDec(),
+			DowntimeJailDecayPeriod: -1,
+		}, true},
+		{"Default Validation Test / Zero downtime jail decay period", fields{
+			UnstakingTime:           0,
+			MaxValidators:           1000,
+			StakeDenom:              "3",
+			StakeMinimum:            1000000,
+			SessionBlock:            30,
+			ProposerAllocation:      0,
+			MaxEvidenceAge:          0,
+			SignedBlocksWindow:      0,
+			MinSignedPerWindow:      types.Dec{},
+			DowntimeJailDuration:    0,
+			SlashFractionDoubleSign: types.Dec{},
+			SlashFractionDowntime:   types.Dec{},
+			DowntimeJailEscalation:  types.OneDec(),
+			DowntimeJailDecayPeriod: 0,
+		}, true},
+		{"Default Validation Test / Wrong earnings retention", fields{
+			UnstakingTime:           0,
+			MaxValidators:           1000,
+			StakeDenom:              "3",
+			StakeMinimum:            1000000,
+			SessionBlock:            30,
+			ProposerAllocation:      0,
+			MaxEvidenceAge:          0,
+			SignedBlocksWindow:      0,
+			MinSignedPerWindow:      types.Dec{},
+			DowntimeJailDuration:    0,
+			SlashFractionDoubleSign: types.Dec{},
+			SlashFractionDowntime:   types.Dec{},
+			DowntimeJailEscalation:  types.OneDec(),
+			EarningsRetention:       -1,
+		}, true},
 		{"Default Validation Test / Valid", fields{
 			UnstakingTime:           0,
 			MaxValidators:           1000,
@@ -279,6 +348,8 @@ func TestParams_Validate(t *testing.T) {
 			DowntimeJailDuration:    0,
 			SlashFractionDoubleSign: types.Dec{},
 			SlashFractionDowntime:   types.Dec{},
+			DowntimeJailEscalation:  types.OneDec(),
+			DowntimeJailDecayPeriod: DefaultDowntimeJailDecayPeriod,
 		}, false},
 	}
 	for _, tt := range tests {
@@ -297,6 +368,9 @@ func TestParams_Validate(t *testing.T) {
 				DowntimeJailDuration:    tt.fields.DowntimeJailDuration,
 				SlashFractionDoubleSign: tt.fields.SlashFractionDoubleSign,
 				SlashFractionDowntime:   tt.fields.SlashFractionDowntime,
+				DowntimeJailEscalation:  tt.fields.DowntimeJailEscalation,
+				DowntimeJailDecayPeriod: tt.fields.DowntimeJailDecayPeriod,
+				EarningsRetention:       tt.fields.EarningsRetention,
 			}
 			if err := p.Validate(); (err != nil) != tt.wantErr {
 				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
@@ -399,6 +473,9 @@ func TestParams_String(t *testing.T) {
 		DowntimeJailDuration    time.Duration
 		SlashFractionDoubleSign types.Dec
 		SlashFractionDowntime   types.Dec
+		DowntimeJailEscalation  types.Dec
+		DowntimeJailDecayPeriod int64
+		EarningsRetention       int64
 	}
 	tests := []struct {
 		name   string
@@ -419,6 +496,9 @@ func TestParams_String(t *testing.T) {
 			SlashFractionDowntime:   DefaultSlashFractionDowntime,
 			SessionBlockFrequency:   DefaultSessionBlocktime,
 			DaoAllocation:           DefaultDAOAllocation,
+			DowntimeJailEscalation:  DefaultDowntimeJailEscalation,
+			DowntimeJailDecayPeriod: DefaultDowntimeJailDecayPeriod,
+			EarningsRetention:       DefaultEarningsRetention,
 		}, fmt.Sprintf(`Params:
   Unstaking Time:          %s
   Max Validators:          %d
@@ -432,7 +512,10 @@ func TestParams_String(t *testing.T) {
   SlashFractionDowntime:   %s
   SessionBlockFrequency    %d
   Proposer Allocation      %d
-  DAO allocation           %d`,
+  DAO allocation           %d
+  DowntimeJailEscalation:  %s
+  DowntimeJailDecayPeriod: %d
+  EarningsRetention:       %d`,
 			DefaultUnstakingTime,
 			DefaultMaxValidators,
 			types.DefaultStakeDenom,
@@ -445,7 +528,10 @@ func TestParams_String(t *testing.T) {
 			DefaultSlashFractionDowntime,
 			DefaultSessionBlocktime,
 			DefaultProposerAllocation,
-			DefaultDAOAllocation)},
+			DefaultDAOAllocation,
+			DefaultDowntimeJailEscalation,
+			DefaultDowntimeJailDecayPeriod,
+			DefaultEarningsRetention)},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -463,6 +549,9 @@ func TestParams_String(t *testing.T) {
 				DowntimeJailDuration:    tt.fields.DowntimeJailDuration,
 				SlashFractionDoubleSign: tt.fields.SlashFractionDoubleSign,
 				SlashFractionDowntime:   tt.fields.SlashFractionDowntime,
+				DowntimeJailEscalation:  tt.fields.DowntimeJailEscalation,
+				DowntimeJailDecayPeriod: tt.fields.DowntimeJailDecayPeriod,
+				EarningsRetention:       tt.fields.EarningsRetention,
 			}
 			if got := p.String(); got != tt.want {
 				t.Errorf("String() = %v, want %v", got, tt.want)
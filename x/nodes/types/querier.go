@@ -1,23 +1,32 @@
 package types
 
 import (
+	"bytes"
+	"fmt"
 	sdk "github.com/pokt-network/posmint/types"
+	"sort"
+	"strings"
 )
 
 // query endpoints supported by the staking Querier
 const (
-	QueryValidators          = "validators"
-	QueryValidator           = "validator"
-	QueryUnstakingValidators = "unstaking_validators"
-	QueryStakedValidators    = "staked_validators"
-	QueryUnstakedValidators  = "unstaked_validators"
-	QueryStakedPool          = "stakedPool"
-	QueryUnstakedPool        = "unstakedPool"
-	QueryParameters          = "parameters"
-	QuerySigningInfo         = "signingInfo"
-	QuerySigningInfos        = "signingInfos"
-	QueryAccountBalance      = "account_balance"
-	QueryAccount             = "account"
+	QueryValidators           = "validators"
+	QueryValidator            = "validator"
+	QueryUnstakingValidators  = "unstaking_validators"
+	QueryStakedValidators     = "staked_validators"
+	QueryUnstakedValidators   = "unstaked_validators"
+	QueryStakedPool           = "stakedPool"
+	QueryUnstakedPool         = "unstakedPool"
+	QueryParameters           = "parameters"
+	QuerySigningInfo          = "signingInfo"
+	QuerySigningInfos         = "signingInfos"
+	QueryAccountBalance       = "account_balance"
+	QueryAccount              = "account"
+	QueryDelegations          = "delegations"
+	QueryUnstakingDelegations = "unstaking_delegations"
+	QuerySlashRecords         = "slash_records"
+	QueryEarnings             = "earnings"
+	QueryUnstakingSchedule    = "unstaking_schedule"
 )
 
 type QueryValidatorParams struct {
@@ -30,12 +39,110 @@ func NewQueryValidatorParams(validatorAddr sdk.Address) QueryValidatorParams {
 	}
 }
 
+// the filters and sort orders of the validators query
+const (
+	StakingStatusStaked    = "staked"
+	StakingStatusUnstaking = "unstaking"
+	StakingStatusUnstaked  = "unstaked"
+	JailedStatusJailed     = "jailed"
+	JailedStatusUnjailed   = "unjailed"
+	SortByAddress          = "address"
+	SortByTokens           = "tokens"
+)
+
+// the page of the validators matching every filter, an empty filter matches all the validators
 type QueryValidatorsParams struct {
-	Page, Limit int
+	Page, Limit   int
+	StakingStatus string // staked, unstaking or unstaked
+	JailedStatus  string // jailed or unjailed
+	Blockchain    string // the hash of a staked chain
+	MinimumStake  int64  // the minimum staked tokens
+	SortBy        string // address (default) or tokens (highest first)
 }
 
 func NewQueryValidatorsParams(page, limit int) QueryValidatorsParams {
-	return QueryValidatorsParams{page, limit}
+	return QueryValidatorsParams{Page: page, Limit: limit}
+}
+
+// stateless check of the filters
+func (p QueryValidatorsParams) Validate() error {
+	switch strings.ToLower(p.StakingStatus) {
+	case "", StakingStatusStaked, StakingStatusUnstaking, StakingStatusUnstaked:
+	default:
+		return fmt.Errorf("invalid staking status %s, can be staked, unstaked, unstaking, or empty", p.StakingStatus)
+	}
+	switch strings.ToLower(p.JailedStatus) {
+	case "", JailedStatusJailed, JailedStatusUnjailed:
+	default:
+		return fmt.Errorf("invalid jailed status %s, can be jailed, unjailed, or empty", p.JailedStatus)
+	}
+	switch strings.ToLower(p.SortBy) {
+	case "", SortByAddress, SortByTokens:
+	default:
+		return fmt.Errorf("invalid sort order %s, can be address, tokens, or empty", p.SortBy)
+	}
+	if p.MinimumStake < 0 {
+		return fmt.Errorf("invalid minimum stake %d, must not be negative", p.MinimumStake)
+	}
+	return nil
+}
+
+// returns true if the validator matches every filter
+func (p QueryValidatorsParams) IsValid(val Validator) bool {
+	switch strings.ToLower(p.StakingStatus) {
+	case StakingStatusStaked:
+		if !val.IsStaked() {
+			return false
+		}
+	case StakingStatusUnstaking:
+		if !val.IsUnstaking() {
+			return false
+		}
+	case StakingStatusUnstaked:
+		if !val.IsUnstaked() {
+			return false
+		}
+	}
+	switch strings.ToLower(p.JailedStatus) {
+	case JailedStatusJailed:
+		if !val.IsJailed() {
+			return false
+		}
+	case JailedStatusUnjailed:
+		if val.IsJailed() {
+			return false
+		}
+	}
+	if p.Blockchain != "" {
+		staked := false
+		for _, chain := range val.Chains {
+			if chain == p.Blockchain {
+				staked = true
+				break
+			}
+		}
+		if !staked {
+			return false
+		}
+	}
+	return val.StakedTokens.GTE(sdk.NewInt(p.MinimumStake))
+}
+
+// returns the validators matching every filter in the sort order
+func (p QueryValidatorsParams) Filter(validators Validators) Validators {
+	filtered := make(Validators, 0)
+	for _, val := range validators {
+		if p.IsValid(val) {
+			filtered = append(filtered, val)
+		}
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if strings.ToLower(p.SortBy) == SortByTokens && !filtered[i].StakedTokens.Equal(filtered[j].StakedTokens) {
+			return filtered[i].StakedTokens.GT(filtered[j].StakedTokens)
+		}
+		return bytes.Compare(filtered[i].Address, filtered[j].Address) < 0
+	})
+	return filtered
 }
 
 type QueryAccountBalanceParams struct {
@@ -85,3 +192,11 @@ type QuerySigningInfosParams struct {
 func NewQuerySigningInfosParams(page, limit int) QuerySigningInfosParams {
 	return QuerySigningInfosParams{page, limit}
 }
+
+type QueryDelegatorParams struct {
+	Address sdk.Address
+}
+
+func NewQueryDelegatorParams(delegatorAddr sdk.Address) QueryDelegatorParams {
+	return QueryDelegatorParams{delegatorAddr}
+}
@@ -160,3 +160,61 @@ func TestNewQueryValidatorsParams(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryValidatorsParams_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		params QueryValidatorsParams
+		hasErr bool
+	}{
+		{"no filters", QueryValidatorsParams{Page: 1}, false},
+		{"every filter", QueryValidatorsParams{Page: 1, StakingStatus: "Staked", JailedStatus: JailedStatusUnjailed, Blockchain: "0001", MinimumStake: 1, SortBy: SortByTokens}, false},
+		{"invalid staking status", QueryValidatorsParams{StakingStatus: "foo"}, true},
+		{"invalid jailed status", QueryValidatorsParams{JailedStatus: "foo"}, true},
+		{"invalid sort order", QueryValidatorsParams{SortBy: "foo"}, true},
+		{"negative minimum stake", QueryValidatorsParams{MinimumStake: -1}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.params.Validate(); (err != nil) != tt.hasErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.hasErr)
+			}
+		})
+	}
+}
+
+func TestQueryValidatorsParams_Filter(t *testing.T) {
+	newValidator := func(addr byte, status types.StakeStatus, jailed bool, chain string, tokens int64) Validator {
+		return Validator{
+			Address:      types.Address([]byte{addr}),
+			Status:       status,
+			Jailed:       jailed,
+			Chains:       []string{chain},
+			StakedTokens: types.NewInt(tokens),
+		}
+	}
+	a := newValidator(1, types.Staked, false, "0001", 10)
+	b := newValidator(2, types.Staked, true, "0002", 30)
+	c := newValidator(3, types.Unstaking, false, "0001", 20)
+	validators := Validators{c, b, a}
+	tests := []struct {
+		name   string
+		params QueryValidatorsParams
+		want   Validators
+	}{
+		{"no filters sorts by address", QueryValidatorsParams{}, Validators{a, b, c}},
+		{"sorts by tokens", QueryValidatorsParams{SortBy: SortByTokens}, Validators{b, c, a}},
+		{"staking status", QueryValidatorsParams{StakingStatus: StakingStatusStaked}, Validators{a, b}},
+		{"jailed status", QueryValidatorsParams{JailedStatus: JailedStatusJailed}, Validators{b}},
+		{"blockchain", QueryValidatorsParams{Blockchain: "0001"}, Validators{a, c}},
+		{"minimum stake", QueryValidatorsParams{MinimumStake: 20}, Validators{b, c}},
+		{"no match", QueryValidatorsParams{StakingStatus: StakingStatusUnstaked}, Validators{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.params.Filter(validators); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Filter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@ type ValidatorSigningInfo struct {
 	JailedUntil         time.Time   `json:"jailed_until" yaml:"jailed_until"`                   // timestamp validator cannot be unjailed until
 	Tombstoned          bool        `json:"tombstoned" yaml:"tombstoned"`                       // whether or not a validator has been tombstoned (killed out of validator set)
 	MissedBlocksCounter int64       `json:"missed_blocks_counter" yaml:"missed_blocks_counter"` // missed blocks counter (to avoid scanning the array every time)
+	JailCount           int64       `json:"jail_count" yaml:"jail_count"`                       // downtime jails within the decay period (escalates the jail duration and slash fraction)
+	LastJailHeight      int64       `json:"last_jail_height" yaml:"last_jail_height"`           // height at which validator was last jailed for downtime
 }
 
 // Return human readable signing info
@@ -25,7 +27,9 @@ func (i ValidatorSigningInfo) String() string {
   Entropy Offset:        %d
   Jailed Until:          %v
   Tombstoned:            %t
-  Missed Blocks Counter: %d`,
+  Missed Blocks Counter: %d
+  Jail Count:            %d
+  Last Jail Height:      %d`,
 		i.Address, i.StartHeight, i.IndexOffset, i.JailedUntil,
-		i.Tombstoned, i.MissedBlocksCounter)
+		i.Tombstoned, i.MissedBlocksCounter, i.JailCount, i.LastJailHeight)
 }
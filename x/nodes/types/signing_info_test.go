@@ -17,6 +17,8 @@ func TestValidatorSigningInfo_String(t *testing.T) {
 		JailedUntil         time.Time
 		Tombstoned          bool
 		MissedBlocksCounter int64
+		JailCount           int64
+		LastJailHeight      int64
 	}
 	var pub ed25519.PubKeyEd25519
 	rand.Read(pub[:])
@@ -36,15 +38,19 @@ func TestValidatorSigningInfo_String(t *testing.T) {
 			JailedUntil:         until,
 			Tombstoned:          false,
 			MissedBlocksCounter: 1,
+			JailCount:           2,
+			LastJailHeight:      3,
 		}, fmt.Sprintf(`Validator Signing Info:
   Address:               %s
   Start Height:          %d
   Entropy Offset:        %d
   Jailed Until:          %v
   Tombstoned:            %t
-  Missed Blocks Counter: %d`,
+  Missed Blocks Counter: %d
+  Jail Count:            %d
+  Last Jail Height:      %d`,
 			ca, 0, 0, until,
-			false, 1)},
+			false, 1, 2, 3)},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -55,6 +61,8 @@ func TestValidatorSigningInfo_String(t *testing.T) {
 				JailedUntil:         tt.fields.JailedUntil,
 				Tombstoned:          tt.fields.Tombstoned,
 				MissedBlocksCounter: tt.fields.MissedBlocksCounter,
+				JailCount:           tt.fields.JailCount,
+				LastJailHeight:      tt.fields.LastJailHeight,
 			}
 			if got := i.String(); got != tt.want {
 				t.Errorf("String() = %v, want %v", got, tt.want)
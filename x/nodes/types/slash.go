@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+	"github.com/pokt-network/posmint/codec"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// reasons of a slash record
+const (
+	SlashReasonDoubleSign = "double_sign"
+	SlashReasonDowntime   = "downtime"
+	SlashReasonChallenge  = "challenge"
+	SlashReasonCustomBurn = "custom_burn"
+)
+
+// SlashRecord - the tokens burned from the stake of a validator at a height
+type SlashRecord struct {
+	Address      sdk.Address `json:"address" yaml:"address"`             // the slashed validator
+	Height       int64       `json:"height" yaml:"height"`               // the height the tokens were burned at
+	Sequence     int64       `json:"sequence" yaml:"sequence"`           // the order of the record among the records of the height
+	Reason       string      `json:"reason" yaml:"reason"`               // double_sign, downtime, challenge or custom_burn
+	Amount       sdk.Int     `json:"amount" yaml:"amount"`               // the tokens burned
+	StakedTokens sdk.Int     `json:"staked_tokens" yaml:"staked_tokens"` // the stake of the validator after the burn
+}
+
+// HashString returns a human readable string representation of a slash record.
+func (sr SlashRecord) String() string {
+	return fmt.Sprintf("Address:\t\t%s\nHeight:\t\t\t%d\nSequence:\t\t%d\nReason:\t\t\t%s\nAmount:\t\t\t%s\nStaked Tokens:\t\t%s",
+		sr.Address, sr.Height, sr.Sequence, sr.Reason, sr.Amount, sr.StakedTokens)
+}
+
+// MUST return the amino encoded version of this slash record
+func MustMarshalSlashRecord(cdc *codec.Codec, record SlashRecord) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(record)
+}
+
+// MUST decode the slash record from the bytes
+func MustUnmarshalSlashRecord(cdc *codec.Codec, bz []byte) (record SlashRecord) {
+	cdc.MustUnmarshalBinaryLengthPrefixed(bz, &record)
+	return
+}
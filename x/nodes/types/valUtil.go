@@ -22,10 +22,18 @@ func (v Validators) String() (out string) {
 
 // HashString returns a human readable string representation of a validator.
 func (v Validator) String() string {
-	return fmt.Sprintf("Address:\t\t%s\nPublic Key:\t\t%s\nJailed:\t\t\t%v\nStatus:\t\t\t%s\nTokens:\t\t\t%s\n"+
+	out := fmt.Sprintf("Address:\t\t%s\nPublic Key:\t\t%s\nJailed:\t\t\t%v\nStatus:\t\t\t%s\nTokens:\t\t\t%s\n"+
 		"ServiceURL:\t\t%s\nChains:\t\t\t%v\nUnstaking Completion Time:\t\t%v",
 		v.Address, v.PublicKey.RawString(), v.Jailed, v.Status, v.StakedTokens, v.ServiceURL, v.Chains, v.UnstakingCompletionTime,
 	)
+	if !v.OutputAddress.Empty() {
+		out += fmt.Sprintf("\nOutput Address:\t\t%s", v.OutputAddress)
+	}
+	if v.GetDelegatorShares().IsPositive() || v.GetCommission().IsPositive() {
+		out += fmt.Sprintf("\nDelegated Tokens:\t\t%s\nDelegator Shares:\t\t%s\nCommission:\t\t%s",
+			v.GetDelegatedTokens(), v.GetDelegatorShares(), v.GetCommission())
+	}
+	return out
 }
 
 // MUST return the amino encoded version of this validator
@@ -50,14 +58,18 @@ func UnmarshalValidator(cdc *codec.Codec, valBytes []byte) (validator Validator,
 
 // this is a helper struct used for JSON de- and encoding only
 type hexValidator struct {
-	Address                 sdk.Address     `json:"address" yaml:"address"`               // the hex address of the validator
-	PublicKey               string          `json:"public_key" yaml:"public_key"`         // the hex consensus public key of the validator
-	Jailed                  bool            `json:"jailed" yaml:"jailed"`                 // has the validator been jailed from staked status?
-	Status                  sdk.StakeStatus `json:"status" yaml:"status"`                 // validator status (staked/unstaking/unstaked)
-	StakedTokens            sdk.Int         `json:"tokens" yaml:"tokens"`                 // how many staked tokens
-	ServiceURL              string          `json:"service_url" yaml:"service_url"`       // the url of the pocket-api
-	Chains                  []string        `json:"chains" yaml:"chains"`                 // the non-native (external) chains hosted
-	UnstakingCompletionTime time.Time       `json:"unstaking_time" yaml:"unstaking_time"` // if unstaking, min time for the validator to complete unstaking
+	Address                 sdk.Address     `json:"address" yaml:"address"`                         // the hex address of the validator
+	PublicKey               string          `json:"public_key" yaml:"public_key"`                   // the hex consensus public key of the validator
+	Jailed                  bool            `json:"jailed" yaml:"jailed"`                           // has the validator been jailed from staked status?
+	Status                  sdk.StakeStatus `json:"status" yaml:"status"`                           // validator status (staked/unstaking/unstaked)
+	StakedTokens            sdk.Int         `json:"tokens" yaml:"tokens"`                           // how many staked tokens
+	ServiceURL              string          `json:"service_url" yaml:"service_url"`                 // the url of the pocket-api
+	Chains                  []string        `json:"chains" yaml:"chains"`                           // the non-native (external) chains hosted
+	UnstakingCompletionTime time.Time       `json:"unstaking_time" yaml:"unstaking_time"`           // if unstaking, min time for the validator to complete unstaking
+	OutputAddress           sdk.Address     `json:"output_address,omitempty" yaml:"output_address"` // the address that receives the rewards and unstaked tokens
+	DelegatedTokens         sdk.Int         `json:"delegated_tokens" yaml:"delegated_tokens"`       // the part of the staked tokens owned by delegators
+	DelegatorShares         sdk.Dec         `json:"delegator_shares" yaml:"delegator_shares"`       // total shares issued to the delegators
+	Commission              sdk.Dec         `json:"commission" yaml:"commission"`                   // the rate of the delegators relay rewards kept by the validator
 }
 
 // Marshals struct into JSON
@@ -77,6 +89,10 @@ func (v Validator) MarshalJSON() ([]byte, error) {
 		Chains:                  v.Chains,
 		StakedTokens:            v.StakedTokens,
 		UnstakingCompletionTime: v.UnstakingCompletionTime,
+		OutputAddress:           v.OutputAddress,
+		DelegatedTokens:         v.GetDelegatedTokens(),
+		DelegatorShares:         v.GetDelegatorShares(),
+		Commission:              v.GetCommission(),
 	})
 }
 
@@ -99,6 +115,10 @@ func (v *Validator) UnmarshalJSON(data []byte) error {
 		StakedTokens:            bv.StakedTokens,
 		Status:                  bv.Status,
 		UnstakingCompletionTime: bv.UnstakingCompletionTime,
+		OutputAddress:           bv.OutputAddress,
+		DelegatedTokens:         bv.DelegatedTokens,
+		DelegatorShares:         bv.DelegatorShares,
+		Commission:              bv.Commission,
 	}
 	return nil
 }
@@ -70,6 +70,10 @@ func TestValidators_String(t *testing.T) {
 			UnstakingCompletionTime: time.Unix(0, 0).UTC(),
 		},
 	}
+	var output crypto.Ed25519PublicKey
+	rand.Read(output[:])
+	withOutput := Validators{v[0]}
+	withOutput[0].OutputAddress = sdk.Address(output.Address())
 	tests := []struct {
 		name    string
 		v       Validators
@@ -79,6 +83,7 @@ func TestValidators_String(t *testing.T) {
 			"ServiceURL:\t\t%s\nChains:\t\t\t%v\nUnstaking Completion Time:\t\t%v",
 			sdk.Address(pub.Address()), pub.RawString(), false, sdk.Staked, sdk.ZeroInt(), "google.com", []string{"b60d7bdd334cd3768d43f14a05c7fe7e886ba5bcb77e1064530052fed1a3f145"}, time.Unix(0, 0).UTC(),
 		)},
+		{"String Test With Output Address", withOutput, v.String() + fmt.Sprintf("\nOutput Address:\t\t%s", sdk.Address(output.Address()))},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
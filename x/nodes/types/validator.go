@@ -12,14 +12,18 @@ import (
 )
 
 type Validator struct {
-	Address                 sdk.Address      `json:"address" yaml:"address"`               // address of the validator; hex encoded in JSON
-	PublicKey               crypto.PublicKey `json:"public_key" yaml:"public_key"`         // the consensus public key of the validator; hex encoded in JSON
-	Jailed                  bool             `json:"jailed" yaml:"jailed"`                 // has the validator been jailed from staked status?
-	Status                  sdk.StakeStatus  `json:"status" yaml:"status"`                 // validator status (staked/unstaking/unstaked)
-	Chains                  []string         `json:"chains" yaml:"chains"`                 // validator non native blockchains
-	ServiceURL              string           `json:"service_url" yaml:"service_url"`       // url where the pocket service api is hosted
-	StakedTokens            sdk.Int          `json:"tokens" yaml:"tokens"`                 // tokens staked in the network
-	UnstakingCompletionTime time.Time        `json:"unstaking_time" yaml:"unstaking_time"` // if unstaking, min time for the validator to complete unstaking
+	Address                 sdk.Address      `json:"address" yaml:"address"`                   // address of the validator; hex encoded in JSON
+	PublicKey               crypto.PublicKey `json:"public_key" yaml:"public_key"`             // the consensus public key of the validator; hex encoded in JSON
+	Jailed                  bool             `json:"jailed" yaml:"jailed"`                     // has the validator been jailed from staked status?
+	Status                  sdk.StakeStatus  `json:"status" yaml:"status"`                     // validator status (staked/unstaking/unstaked)
+	Chains                  []string         `json:"chains" yaml:"chains"`                     // validator non native blockchains
+	ServiceURL              string           `json:"service_url" yaml:"service_url"`           // url where the pocket service api is hosted
+	StakedTokens            sdk.Int          `json:"tokens" yaml:"tokens"`                     // tokens staked in the network
+	UnstakingCompletionTime time.Time        `json:"unstaking_time" yaml:"unstaking_time"`     // if unstaking, min time for the validator to complete unstaking
+	OutputAddress           sdk.Address      `json:"output_address" yaml:"output_address"`     // optional address that receives the rewards and unstaked tokens
+	DelegatedTokens         sdk.Int          `json:"delegated_tokens" yaml:"delegated_tokens"` // the part of the staked tokens owned by delegators
+	DelegatorShares         sdk.Dec          `json:"delegator_shares" yaml:"delegator_shares"` // total shares issued to the delegators
+	Commission              sdk.Dec          `json:"commission" yaml:"commission"`             // the rate of the delegators relay rewards kept by the validator
 }
 
 // NewValidator - initialize a new validator
@@ -33,6 +37,9 @@ func NewValidator(addr sdk.Address, consPubKey crypto.PublicKey, chains []string
 		StakedTokens:            tokensToStake,
 		ServiceURL:              serviceURL,
 		UnstakingCompletionTime: time.Unix(0, 0).UTC(), // zero out because status: staked
+		DelegatedTokens:         sdk.ZeroInt(),
+		DelegatorShares:         sdk.ZeroDec(),
+		Commission:              sdk.ZeroDec(),
 	}
 }
 
@@ -75,6 +82,66 @@ func (v Validator) AddStakedTokens(tokens sdk.Int) Validator {
 	return v
 }
 
+// RemoveTokensProportionally removes tokens from a validator, the delegators bear their share of the removed tokens
+// NOTE used for slashes, the delegator shares are unchanged so each delegation loses value proportionally
+func (v Validator) RemoveTokensProportionally(tokens sdk.Int) Validator {
+	delegated := v.GetDelegatedTokens()
+	if delegated.IsPositive() && v.StakedTokens.IsPositive() {
+		delegatedTokensToRemove := tokens.Mul(delegated).Quo(v.StakedTokens) // truncates
+		v.DelegatedTokens = delegated.Sub(sdk.MinInt(delegatedTokensToRemove, delegated))
+	}
+	return v.RemoveStakedTokens(tokens)
+}
+
+// AddDelegatedTokens adds delegated tokens to a validator and returns the shares issued for them
+func (v Validator) AddDelegatedTokens(tokens sdk.Int) (Validator, sdk.Dec, sdk.Error) {
+	shares, err := v.SharesFromTokens(tokens)
+	if err != nil {
+		return v, sdk.ZeroDec(), err
+	}
+	v = v.AddStakedTokens(tokens)
+	v.DelegatedTokens = v.GetDelegatedTokens().Add(tokens)
+	v.DelegatorShares = v.GetDelegatorShares().Add(shares)
+	return v, shares, nil
+}
+
+// RemoveDelegatorShares removes delegator shares from a validator and returns the tokens they were worth
+func (v Validator) RemoveDelegatorShares(shares sdk.Dec) (Validator, sdk.Int) {
+	var tokens sdk.Int
+	remainingShares := v.GetDelegatorShares().Sub(shares)
+	if remainingShares.IsZero() {
+		// the last shares take the remaining tokens so no dust is left behind
+		tokens = v.GetDelegatedTokens()
+		remainingShares = sdk.ZeroDec()
+	} else {
+		tokens = v.TokensFromShares(shares).TruncateInt()
+	}
+	v.DelegatedTokens = v.GetDelegatedTokens().Sub(tokens)
+	v.DelegatorShares = remainingShares
+	v = v.RemoveStakedTokens(tokens)
+	return v, tokens
+}
+
+// SharesFromTokens returns the shares a delegation of tokens is worth at the current exchange rate
+func (v Validator) SharesFromTokens(tokens sdk.Int) (sdk.Dec, sdk.Error) {
+	if v.GetDelegatorShares().IsZero() {
+		// the first delegation sets the exchange rate to one share per token
+		return tokens.ToDec(), nil
+	}
+	if v.GetDelegatedTokens().IsZero() {
+		return sdk.ZeroDec(), ErrInvalidExchangeRate(DefaultCodespace)
+	}
+	return v.GetDelegatorShares().MulInt(tokens).QuoInt(v.GetDelegatedTokens()), nil
+}
+
+// TokensFromShares returns the tokens the delegator shares are worth at the current exchange rate
+func (v Validator) TokensFromShares(shares sdk.Dec) sdk.Dec {
+	if v.GetDelegatorShares().IsZero() {
+		return sdk.ZeroDec()
+	}
+	return shares.MulInt(v.GetDelegatedTokens()).Quo(v.GetDelegatorShares())
+}
+
 // compares the vital fields of two validator structures
 func (v Validator) Equals(v2 Validator) bool {
 	return v.PublicKey.Equals(v2.PublicKey) &&
@@ -83,6 +150,43 @@ func (v Validator) Equals(v2 Validator) bool {
 		v.StakedTokens.Equal(v2.StakedTokens)
 }
 
+// the address that receives the rewards and unstaked tokens, the validator address if no output address is set
+func (v Validator) GetOutputAddress() sdk.Address {
+	if v.OutputAddress.Empty() {
+		return v.Address
+	}
+	return v.OutputAddress
+}
+
+// the delegated tokens of the validator, zero if never delegated to
+func (v Validator) GetDelegatedTokens() sdk.Int {
+	if v.DelegatedTokens == (sdk.Int{}) {
+		return sdk.ZeroInt()
+	}
+	return v.DelegatedTokens
+}
+
+// the shares issued to the delegators of the validator, zero if never delegated to
+func (v Validator) GetDelegatorShares() sdk.Dec {
+	if v.DelegatorShares.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return v.DelegatorShares
+}
+
+// the commission rate of the validator, zero if never set
+func (v Validator) GetCommission() sdk.Dec {
+	if v.Commission.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return v.Commission
+}
+
+// the staked tokens owned by the validator itself
+func (v Validator) GetSelfStake() sdk.Int {
+	return v.StakedTokens.Sub(v.GetDelegatedTokens())
+}
+
 // UpdateStatus updates the staking status
 func (v Validator) UpdateStatus(newStatus sdk.StakeStatus) Validator {
 	v.Status = newStatus
@@ -37,6 +37,9 @@ func TestNewValidator(t *testing.T) {
 				Chains:                  []string{"b60d7bdd334cd3768d43f14a05c7fe7e886ba5bcb77e1064530052fed1a3f145"},
 				ServiceURL:              "google.com",
 				UnstakingCompletionTime: time.Unix(0, 0).UTC(), // zero out because status: staked
+				DelegatedTokens:         sdk.ZeroInt(),
+				DelegatorShares:         sdk.ZeroDec(),
+				Commission:              sdk.ZeroDec(),
 			}},
 	}
 	for _, tt := range tests {
@@ -1063,3 +1066,50 @@ func TestValidator_GetChains(t *testing.T) {
 		})
 	}
 }
+
+func TestValidator_DelegatorShares(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	v := NewValidator(sdk.Address(pub.Address()), pub, []string{"b60d7bdd334cd3768d43f14a05c7fe7e886ba5bcb77e1064530052fed1a3f145"}, "google.com", sdk.NewInt(1000))
+
+	// the first delegation is issued one share per token
+	v, shares, err := v.AddDelegatedTokens(sdk.NewInt(1000))
+	if err != nil || !shares.Equal(sdk.NewDec(1000)) {
+		t.Fatalf("AddDelegatedTokens() = %v, %v, want %v", shares, err, sdk.NewDec(1000))
+	}
+	if !v.StakedTokens.Equal(sdk.NewInt(2000)) || !v.GetSelfStake().Equal(sdk.NewInt(1000)) {
+		t.Errorf("AddDelegatedTokens() staked = %v, self = %v", v.StakedTokens, v.GetSelfStake())
+	}
+	// a slash of half the stake halves the delegated tokens and the worth of the shares
+	v = v.RemoveTokensProportionally(sdk.NewInt(1000))
+	if !v.GetDelegatedTokens().Equal(sdk.NewInt(500)) || !v.StakedTokens.Equal(sdk.NewInt(1000)) {
+		t.Errorf("RemoveTokensProportionally() delegated = %v, staked = %v", v.GetDelegatedTokens(), v.StakedTokens)
+	}
+	if got := v.TokensFromShares(sdk.NewDec(1000)); !got.Equal(sdk.NewDec(500)) {
+		t.Errorf("TokensFromShares() = %v, want %v", got, sdk.NewDec(500))
+	}
+	// later delegations are issued shares at the new exchange rate
+	v, shares, _ = v.AddDelegatedTokens(sdk.NewInt(500))
+	if !shares.Equal(sdk.NewDec(1000)) {
+		t.Errorf("AddDelegatedTokens() shares = %v, want %v", shares, sdk.NewDec(1000))
+	}
+	v, tokens := v.RemoveDelegatorShares(sdk.NewDec(500))
+	if !tokens.Equal(sdk.NewInt(250)) {
+		t.Errorf("RemoveDelegatorShares() = %v, want %v", tokens, sdk.NewInt(250))
+	}
+	// the last shares take all of the remaining delegated tokens
+	v, tokens = v.RemoveDelegatorShares(sdk.NewDec(1500))
+	if !tokens.Equal(sdk.NewInt(750)) || !v.GetDelegatedTokens().IsZero() || !v.GetDelegatorShares().IsZero() {
+		t.Errorf("RemoveDelegatorShares() = %v, delegated = %v, shares = %v", tokens, v.GetDelegatedTokens(), v.GetDelegatorShares())
+	}
+	if !v.StakedTokens.Equal(sdk.NewInt(500)) {
+		t.Errorf("RemoveDelegatorShares() staked = %v, want %v", v.StakedTokens, sdk.NewInt(500))
+	}
+}
+
+func TestValidator_SharesFromTokensInvalidExchangeRate(t *testing.T) {
+	v := Validator{StakedTokens: sdk.NewInt(10), DelegatedTokens: sdk.ZeroInt(), DelegatorShares: sdk.NewDec(10)}
+	if _, err := v.SharesFromTokens(sdk.NewInt(1)); !reflect.DeepEqual(err, ErrInvalidExchangeRate(DefaultCodespace)) {
+		t.Errorf("SharesFromTokens() = %v, want %v", err, ErrInvalidExchangeRate(DefaultCodespace))
+	}
+}
@@ -15,6 +15,8 @@ func NewHandler(keeper keeper.Keeper) sdk.Handler {
 			return handleClaimMsg(ctx, keeper, msg)
 		case types.MsgProof:
 			return handleProofMsg(ctx, keeper, msg)
+		case types.MsgAppEvidence:
+			return handleAppEvidenceMsg(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized pocketcore Msg type: %v", msg.Type())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -32,6 +34,12 @@ func handleClaimMsg(ctx sdk.Ctx, k keeper.Keeper, msg types.MsgClaim) sdk.Result
 	if err != nil {
 		return sdk.ErrInternal(err.Error()).Result()
 	}
+	// only the relays count towards the application usage, against the allowance of the session
+	if msg.EvidenceType == types.RelayEvidence {
+		if app, err := k.GetSessionApp(ctx, msg.SessionHeader); err == nil {
+			k.RecordAppUsage(ctx, msg.SessionHeader, app.MaxRelays, msg.TotalProofs, 0)
+		}
+	}
 	// create the event
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -63,6 +71,11 @@ func handleProofMsg(ctx sdk.Ctx, k keeper.Keeper, proof types.MsgProof) sdk.Resu
 	if err != nil {
 		return err.Result()
 	}
+	if claim.EvidenceType == types.RelayEvidence {
+		if app, err := k.GetSessionApp(ctx, claim.SessionHeader); err == nil {
+			k.RecordAppUsage(ctx, claim.SessionHeader, app.MaxRelays, 0, claim.TotalProofs)
+		}
+	}
 	// create the event
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -72,3 +85,23 @@ func handleProofMsg(ctx sdk.Ctx, k keeper.Keeper, proof types.MsgProof) sdk.Resu
 	})
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+func handleAppEvidenceMsg(ctx sdk.Ctx, k keeper.Keeper, msg types.MsgAppEvidence) sdk.Result {
+	// validate the evidence against the session of the application
+	app, err := k.ValidateAppEvidence(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+	// burn and jail the application
+	k.ExecuteAppEvidence(ctx, msg.Evidence, app)
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAppEvidence,
+			sdk.NewAttribute(types.AttributeKeyApplication, app.GetAddress().String()),
+			sdk.NewAttribute(types.AttributeKeyInfraction, msg.Evidence.Infraction()),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ReporterAddress.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
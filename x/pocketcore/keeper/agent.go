@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"fmt"
+	"github.com/pokt-network/pocket-core/x/nodes/exported"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/posmint/crypto/keys"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth"
+	"github.com/pokt-network/posmint/x/auth/util"
+	"github.com/tendermint/tendermint/rpc/client"
+)
+
+// auto sends an unjail for the self node once it is eligible to be unjailed
+func (k Keeper) SendUnjailTx(ctx sdk.Ctx, n client.Client, keybase keys.Keybase, unjailTx func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, address sdk.Address) (*sdk.TxResponse, error)) {
+	node, ok := k.unjailableSelfNode(ctx)
+	if !ok {
+		return
+	}
+	// generate the auto txbuilder and clictx
+	txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, nodesTypes.MsgUnjailName, nodesTypes.NodeFeeMap[nodesTypes.MsgUnjailName], n, keybase, k)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured creating the tx builder for the unjailTX:\n%v", err))
+		return
+	}
+	if _, err := unjailTx(cliCtx, txBuilder, node.GetAddress()); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured sending the unjailTX:\n%v", err))
+	}
+}
+
+// auto sends a stake top up for the self node with its balance above the compound reserve
+func (k Keeper) SendCompoundTx(ctx sdk.Ctx, n client.Client, keybase keys.Keybase, stakeTx func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, node exported.ValidatorI, amount sdk.Int) (*sdk.TxResponse, error)) {
+	node, stake, ok := k.compoundedSelfNodeStake(ctx)
+	if !ok {
+		return
+	}
+	// generate the auto txbuilder and clictx
+	txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, nodesTypes.MsgStakeName, nodesTypes.NodeFeeMap[nodesTypes.MsgStakeName], n, keybase, k)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured creating the tx builder for the compoundTX:\n%v", err))
+		return
+	}
+	// the stake is edited in place, the chains and service url are unchanged
+	if _, err := stakeTx(cliCtx, txBuilder, node, stake); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured sending the compoundTX:\n%v", err))
+	}
+}
+
+// returns the self node if it is jailed and may be unjailed
+func (k Keeper) unjailableSelfNode(ctx sdk.Ctx) (node exported.ValidatorI, ok bool) {
+	node, er := k.GetSelfNode(ctx)
+	if er != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured retrieving the self node for the unjailTX:\n%v", er))
+		return nil, false
+	}
+	if !node.IsJailed() || !k.coinbaseOwnsSelfNode(ctx, node, "unjailTX") {
+		return nil, false
+	}
+	// the jail duration must be over and the stake above the minimum
+	if _, er := k.posKeeper.ValidateUnjail(ctx, node.GetAddress()); er != nil {
+		return nil, false
+	}
+	return node, true
+}
+
+// returns the self node and its stake once the balance above the compound reserve and the stake fee is restaked
+func (k Keeper) compoundedSelfNodeStake(ctx sdk.Ctx) (node exported.ValidatorI, stake sdk.Int, ok bool) {
+	node, er := k.GetSelfNode(ctx)
+	if er != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured retrieving the self node for the compoundTX:\n%v", er))
+		return nil, sdk.ZeroInt(), false
+	}
+	// only a staked node may top up its stake
+	if !node.IsStaked() || node.IsJailed() || k.posKeeper.IsWaitingValidator(ctx, node.GetAddress()) {
+		return nil, sdk.ZeroInt(), false
+	}
+	if !k.coinbaseOwnsSelfNode(ctx, node, "compoundTX") {
+		return nil, sdk.ZeroInt(), false
+	}
+	// the rewards are minted to the output address, which the coinbase cannot spend from
+	if !node.GetOutputAddress().Equals(node.GetAddress()) {
+		ctx.Logger().Info(fmt.Sprintf("the rewards of the self node go to the output address %s, the compoundTX is not sent", node.GetOutputAddress()))
+		return nil, sdk.ZeroInt(), false
+	}
+	fee := sdk.NewInt(nodesTypes.NodeFeeMap[nodesTypes.MsgStakeName])
+	amount := k.posKeeper.GetBalance(ctx, node.GetAddress()).Sub(k.CompoundReserve).Sub(fee)
+	if !amount.IsPositive() {
+		return nil, sdk.ZeroInt(), false
+	}
+	return node, node.GetTokens().Add(amount), true
+}
+
+// the auto txs are signed by the coinbase, which no longer owns the self node once its consensus key is rotated
+func (k Keeper) coinbaseOwnsSelfNode(ctx sdk.Ctx, node exported.ValidatorI, txName string) bool {
+	kp, err := k.Keybase.GetCoinbase()
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured retrieving the coinbase for the %s:\n%v", txName, err))
+		return false
+	}
+	if !sdk.Address(kp.GetAddress()).Equals(node.GetAddress()) {
+		ctx.Logger().Info(fmt.Sprintf("the self node %s is not owned by the coinbase %s after a key rotation, the %s is not sent", node.GetAddress(), kp.GetAddress(), txName))
+		return false
+	}
+	return true
+}
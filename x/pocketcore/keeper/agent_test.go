@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	nodesKeeper "github.com/pokt-network/pocket-core/x/nodes/keeper"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestKeeper_UnjailableSelfNode(t *testing.T) {
+	ctx, _, _, _, keeper, _ := createTestInput(t, false)
+	ctx = ctx.WithBlockTime(time.Now())
+	nk := keeper.posKeeper.(nodesKeeper.Keeper)
+	kp, err := keeper.Keybase.GetCoinbase()
+	assert.Nil(t, err)
+	addr := sdk.Address(kp.GetAddress())
+	// not jailed
+	_, ok := keeper.unjailableSelfNode(ctx)
+	assert.False(t, ok)
+	// jailed and the jail duration is not over
+	nk.JailValidator(ctx, addr)
+	info, found := nk.GetValidatorSigningInfo(ctx, addr)
+	assert.True(t, found)
+	info.JailedUntil = time.Now().Add(time.Hour)
+	nk.SetValidatorSigningInfo(ctx, addr, info)
+	_, ok = keeper.unjailableSelfNode(ctx)
+	assert.False(t, ok)
+	// jailed and the jail duration is over
+	info.JailedUntil = time.Unix(0, 0)
+	nk.SetValidatorSigningInfo(ctx, addr, info)
+	node, ok := keeper.unjailableSelfNode(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, addr, node.GetAddress())
+}
+
+func TestKeeper_CompoundedSelfNodeStake(t *testing.T) {
+	ctx, _, _, _, keeper, _ := createTestInput(t, false)
+	keeper.CompoundReserve = sdk.NewInt(1000)
+	nk := keeper.posKeeper.(nodesKeeper.Keeper)
+	kp, err := keeper.Keybase.GetCoinbase()
+	assert.Nil(t, err)
+	addr := sdk.Address(kp.GetAddress())
+	self, er := keeper.GetSelfNode(ctx)
+	assert.Nil(t, er)
+	fee := sdk.NewInt(nodesTypes.NodeFeeMap[nodesTypes.MsgStakeName])
+	// the balance does not cover the reserve and the fee
+	setBalance(t, ctx, nk, addr, keeper.CompoundReserve.Add(fee))
+	_, _, ok := keeper.compoundedSelfNodeStake(ctx)
+	assert.False(t, ok)
+	// the balance above the reserve and the fee is restaked
+	setBalance(t, ctx, nk, addr, keeper.CompoundReserve.Add(fee).Add(sdk.NewInt(500)))
+	node, stake, ok := keeper.compoundedSelfNodeStake(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, addr, node.GetAddress())
+	assert.True(t, stake.Equal(self.GetTokens().Add(sdk.NewInt(500))))
+	// the rewards of a node with an output address are not compounded
+	validator, _ := nk.GetValidator(ctx, addr)
+	validator.OutputAddress = getRandomValidatorAddress()
+	nk.SetValidator(ctx, validator)
+	_, _, ok = keeper.compoundedSelfNodeStake(ctx)
+	assert.False(t, ok)
+	validator.OutputAddress = nil
+	nk.SetValidator(ctx, validator)
+	// a jailed node may not top up its stake
+	nk.JailValidator(ctx, addr)
+	_, _, ok = keeper.compoundedSelfNodeStake(ctx)
+	assert.False(t, ok)
+}
+
+func TestKeeper_CoinbaseOwnsSelfNode(t *testing.T) {
+	ctx, _, _, _, keeper, _ := createTestInput(t, false)
+	nk := keeper.posKeeper.(nodesKeeper.Keeper)
+	kp, err := keeper.Keybase.GetCoinbase()
+	assert.Nil(t, err)
+	self, er := keeper.GetSelfNode(ctx)
+	assert.Nil(t, er)
+	assert.True(t, keeper.coinbaseOwnsSelfNode(ctx, self, "unjailTX"))
+	// once the consensus key is rotated to the coinbase the node is owned by another account
+	owner, _ := nk.GetValidator(ctx, sdk.Address(kp.GetAddress()))
+	owner.Address = getRandomValidatorAddress()
+	nk.SetValidator(ctx, owner)
+	nk.SetConsensusAddress(ctx, sdk.Address(kp.GetAddress()), owner.Address)
+	self, er = keeper.GetSelfNode(ctx)
+	assert.Nil(t, er)
+	assert.Equal(t, owner.Address, self.GetAddress())
+	assert.False(t, keeper.coinbaseOwnsSelfNode(ctx, self, "unjailTX"))
+	_, _, ok := keeper.compoundedSelfNodeStake(ctx)
+	assert.False(t, ok)
+}
+
+func setBalance(t *testing.T, ctx sdk.Ctx, nk nodesKeeper.Keeper, addr sdk.Address, amount sdk.Int) {
+	err := nk.AccountKeeper.(auth.Keeper).SetCoins(ctx, addr, sdk.NewCoins(sdk.NewCoin(nk.StakeDenom(ctx), amount)))
+	assert.Nil(t, err)
+}
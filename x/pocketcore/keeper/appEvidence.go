@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+	"github.com/pokt-network/pocket-core/x/apps/exported"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// validate the application evidence against the world state, returns the application to penalize
+func (k Keeper) ValidateAppEvidence(ctx sdk.Ctx, msg pc.MsgAppEvidence) (exported.ApplicationI, sdk.Error) {
+	header := msg.Evidence.SessionHeader()
+	// replay protection: an application is only penalized once per session
+	if k.IsAppEvidenceExecuted(ctx, header) {
+		return nil, pc.NewAppEvidenceReplayError(pc.ModuleName)
+	}
+	// retrieve the session snapshot persisted at the session block
+	snapshot, found := k.GetSessionSnapshot(ctx, header.SessionBlockHeight, header.Chain)
+	if !found {
+		return nil, pc.NewSessionSnapshotNotFoundError(pc.ModuleName)
+	}
+	// get the servicer at the time of the session, the evidence may be signed with a rotated consensus key
+	node, err := snapshot.Node(k.GetValidatorAddress(ctx, msg.ReporterAddress))
+	if err != nil {
+		return nil, err
+	}
+	// get the application at the time of the session
+	app, err := snapshot.App(header.ApplicationPubKey)
+	if err != nil {
+		return nil, err
+	}
+	session, err := snapshot.Session(header)
+	if err != nil {
+		return nil, err
+	}
+	// the reporter must be a node of the session
+	err = session.Validate(ctx, node, app)
+	if err != nil {
+		return nil, err
+	}
+	// the evidence follows the claim window of the session
+	if k.ClaimIsMature(ctx, header.SessionBlockHeight) {
+		return nil, pc.NewExpiredProofsSubmissionError(pc.ModuleName)
+	}
+	// validate the relays against the application of the session
+	err = msg.Evidence.Validate([]string{header.Chain}, int(app.SessionNodeCount), app.MaxRelays.Int64())
+	if err != nil {
+		return nil, err
+	}
+	// only a staked application can be penalized
+	current, found := k.GetAppFromPublicKey(ctx, header.ApplicationPubKey)
+	if !found || !current.IsStaked() {
+		return nil, pc.NewAppNotStakedError(pc.ModuleName)
+	}
+	return current, nil
+}
+
+// burn a fraction of the application stake and jail the application for the misbehaviour
+func (k Keeper) ExecuteAppEvidence(ctx sdk.Ctx, evidence pc.AppEvidence, app exported.ApplicationI) {
+	k.appKeeper.BurnForMisbehaviour(ctx, app.GetAddress())
+	if !app.IsJailed() {
+		k.appKeeper.JailApplication(ctx, app.GetAddress())
+	}
+	k.SetAppEvidence(ctx, evidence)
+	ctx.Logger().Info(fmt.Sprintf("application %s penalized for %s", app.GetAddress().String(), evidence.Infraction()))
+}
+
+// record the executed application evidence in the world state
+func (k Keeper) SetAppEvidence(ctx sdk.Ctx, evidence pc.AppEvidence) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(pc.KeyForAppEvidence(evidence.SessionHeader()), []byte(evidence.Infraction()))
+}
+
+// has the application already been penalized for this session?
+func (k Keeper) IsAppEvidenceExecuted(ctx sdk.Ctx, header pc.SessionHeader) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(pc.KeyForAppEvidence(header))
+}
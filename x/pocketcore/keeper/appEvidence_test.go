@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestKeeper_ExecuteAppEvidence(t *testing.T) {
+	ctx, vals, apps, _, keeper, _ := createTestInput(t, false)
+	app := apps[0]
+	relay := types.RelayProof{
+		Entropy:            1,
+		SessionBlockHeight: 1,
+		ServicerPubKey:     vals[0].PublicKey.RawString(),
+		Blockchain:         app.Chains[0],
+		Token: types.AAT{
+			Version:              "0.0.1",
+			ApplicationPublicKey: app.PublicKey.RawString(),
+			ClientPublicKey:      getRandomPubKey().RawString(),
+		},
+	}
+	evidence := types.RelaysOverCapEvidence{Relays: []types.RelayProof{relay}}
+	assert.False(t, keeper.IsAppEvidenceExecuted(ctx, evidence.SessionHeader()))
+	a, found := keeper.GetApp(ctx, app.Address)
+	assert.True(t, found)
+	keeper.ExecuteAppEvidence(ctx, evidence, a)
+	// the application is jailed and the session is marked as executed
+	a, _ = keeper.GetApp(ctx, app.Address)
+	assert.True(t, a.IsJailed())
+	assert.True(t, keeper.IsAppEvidenceExecuted(ctx, evidence.SessionHeader()))
+	// replaying evidence for the same session is rejected
+	_, err := keeper.ValidateAppEvidence(ctx, types.MsgAppEvidence{Evidence: evidence, ReporterAddress: vals[0].Address})
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(types.CodeAppEvidenceReplayError), err.Code())
+}
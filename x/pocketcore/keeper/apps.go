@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"github.com/pokt-network/pocket-core/x/apps/exported"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
 )
@@ -26,3 +27,13 @@ func (k Keeper) GetAppFromPublicKey(ctx sdk.Ctx, pubKey string) (app exported.Ap
 	}
 	return k.GetApp(ctx, sdk.Address(pk.Address()))
 }
+
+// record the relays claimed or proven for an application session in the apps usage index, against the max relays
+// of the application for the chain at the session block
+func (k Keeper) RecordAppUsage(ctx sdk.Ctx, header types.SessionHeader, maxRelays sdk.Int, claimedRelays, provenRelays int64) {
+	pk, err := crypto.NewPublicKey(header.ApplicationPubKey)
+	if err != nil {
+		return
+	}
+	k.appKeeper.RecordRelayUsage(ctx, sdk.Address(pk.Address()), header.Chain, header.SessionBlockHeight, maxRelays, claimedRelays, provenRelays)
+}
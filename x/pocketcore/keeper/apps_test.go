@@ -1 +1,37 @@
 package keeper
+
+import (
+	appsKeeper "github.com/pokt-network/pocket-core/x/apps/keeper"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestKeeper_RecordAppUsage(t *testing.T) {
+	ctx, _, _, _, keeper, _ := createTestInput(t, false)
+	app := getTestApplication()
+	header := types.SessionHeader{
+		ApplicationPubKey:  app.PublicKey.RawString(),
+		Chain:              app.Chains[0],
+		SessionBlockHeight: ctx.BlockHeight(),
+	}
+	sessionApp, err := keeper.GetSessionApp(ctx, header)
+	assert.Nil(t, err)
+	assert.True(t, sessionApp.MaxRelays.Equal(app.GetChainMaxRelays(header.Chain)))
+	// a change of the application after the session block does not change the allowance of the session
+	current := keeper.appKeeper.(appsKeeper.Keeper)
+	changed := app
+	changed.MaxRelays = app.MaxRelays.MulRaw(2)
+	current.SetApplication(ctx, changed)
+	keeper.RecordAppUsage(ctx, header, sessionApp.MaxRelays, 100, 0)
+	keeper.RecordAppUsage(ctx, header, sessionApp.MaxRelays, 0, 100)
+	usage, found := current.GetUsage(ctx, app.Address, header.SessionBlockHeight, header.Chain)
+	assert.True(t, found)
+	assert.Equal(t, int64(100), usage.ClaimedRelays)
+	assert.Equal(t, int64(100), usage.ProvenRelays)
+	assert.True(t, usage.MaxRelays.Equal(sessionApp.MaxRelays))
+	// no usage without a session snapshot
+	header.SessionBlockHeight++
+	_, err = keeper.GetSessionApp(ctx, header)
+	assert.Equal(t, types.NewSessionSnapshotNotFoundError(types.ModuleName), err)
+}
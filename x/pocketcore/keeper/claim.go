@@ -39,7 +39,7 @@ func (k Keeper) SendClaimTx(ctx sdk.Ctx, n client.Client, keybase keys.Keybase,
 		}
 		// check the current state to see if the unverified evidence has already been sent and processed (if so, then skip this evidence)
 		ctx.Logger().Info(fmt.Sprintf("get claim for address: %s", kp.GetAddress().String()))
-		if _, found := k.GetClaim(ctx, sdk.Address(kp.GetAddress()), evidence.SessionHeader, evidenceType); found {
+		if _, found := k.GetClaim(ctx, k.GetValidatorAddress(ctx, sdk.Address(kp.GetAddress())), evidence.SessionHeader, evidenceType); found {
 			continue
 		}
 		if k.ClaimIsMature(ctx, evidence.SessionBlockHeight) {
@@ -49,7 +49,7 @@ func (k Keeper) SendClaimTx(ctx sdk.Ctx, n client.Client, keybase keys.Keybase,
 		// generate the merkle root for this evidence
 		root := evidence.GenerateMerkleRoot()
 		// generate the auto txbuilder and clictx
-		txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, pc.MsgClaimName, n, keybase, k)
+		txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, pc.MsgClaimName, pc.PocketFeeMap[pc.MsgClaimName], n, keybase, k)
 		if err != nil {
 			ctx.Logger().Error(fmt.Sprintf("an error occured retrieving the coinbase for the claimTX:\n%v", err))
 			return
@@ -65,45 +65,29 @@ func (k Keeper) ValidateClaim(ctx sdk.Ctx, claim pc.MsgClaim) sdk.Error {
 	if claim.EvidenceType == 0 {
 		return pc.NewNoEvidenceTypeErr(pc.ModuleName)
 	}
-	// get the session context
-	sessionContext, er := ctx.PrevCtx(claim.SessionBlockHeight)
-	if er != nil {
-		return sdk.ErrInternal(er.Error())
-	}
-	// if is not a pocket supported blockchain then return not supported error
-	if !k.IsPocketSupportedBlockchain(sessionContext, claim.Chain) {
-		return pc.NewChainNotSupportedErr(pc.ModuleName)
-	}
-	// get the node from the keeper at the time of the session
-	node, found := k.GetNode(sessionContext, claim.FromAddress)
-	// if not found return not found error
+	// retrieve the session snapshot persisted at the session block, only the supported chains have one
+	snapshot, found := k.GetSessionSnapshot(ctx, claim.SessionBlockHeight, claim.Chain)
 	if !found {
-		return pc.NewNodeNotFoundErr(pc.ModuleName)
+		return pc.NewSessionSnapshotNotFoundError(pc.ModuleName)
 	}
-	// get the application at the session context
-	app, found := k.GetAppFromPublicKey(sessionContext, claim.ApplicationPubKey)
-	// if not found return not found error
-	if !found {
-		return pc.NewAppNotFoundError(pc.ModuleName)
+	// get the node at the time of the session, the claim may be signed with a rotated consensus key
+	node, err := snapshot.Node(k.GetValidatorAddress(ctx, claim.FromAddress))
+	if err != nil {
+		return err
 	}
-	// get the session node count for the time of the session
-	sessionNodeCount := int(k.SessionNodeCount(sessionContext))
-	// check cache
-	session, found := pc.GetSession(claim.SessionHeader)
-	// if not found generate the session
-	if !found {
-		var err sdk.Error
-		nodes := k.GetAllNodes(ctx)
-		session, err = pc.NewSession(claim.SessionHeader, pc.BlockHash(sessionContext), nodes, sessionNodeCount)
-		if err != nil {
-			ctx.Logger().Error(fmt.Errorf("Could not generate session with public key: %s,  for chain: %s", app.GetPublicKey().RawString(), claim.Chain).Error())
-			return err
-		}
-		// add to cache
-		pc.SetSession(session)
+	// get the application at the time of the session
+	app, err := snapshot.App(claim.ApplicationPubKey)
+	if err != nil {
+		return err
+	}
+	// retrieve the session from the cache or the session snapshot
+	session, err := snapshot.Session(claim.SessionHeader)
+	if err != nil {
+		ctx.Logger().Error(fmt.Errorf("Could not generate session with public key: %s,  for chain: %s", app.PublicKey, claim.Chain).Error())
+		return err
 	}
 	// validate the session
-	err := session.Validate(ctx, node, app, sessionNodeCount)
+	err = session.Validate(ctx, node, app)
 	if err != nil {
 		return err
 	}
@@ -114,7 +98,10 @@ func (k Keeper) ValidateClaim(ctx sdk.Ctx, claim pc.MsgClaim) sdk.Error {
 	return nil
 }
 
+// set the claim in the world state, a claim signed with a rotated consensus key is stored under the validator address
+// so that the proof of the claim finds it
 func (k Keeper) SetClaim(ctx sdk.Ctx, msg pc.MsgClaim) error {
+	msg.FromAddress = k.GetValidatorAddress(ctx, msg.FromAddress)
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshalBinaryBare(msg)
 	key, err := pc.KeyForClaim(ctx, msg.FromAddress, msg.SessionHeader, msg.EvidenceType)
@@ -9,7 +9,7 @@ import (
 )
 
 func TestKeeper_GetSetClaim(t *testing.T) {
-	ctx, _, _, _, keeper, _ := createTestInput(t, false)
+	ctx, _, _, _, keeper, keys := createTestInput(t, false)
 	npk, header, _, _ := simulateRelays(t, keeper, &ctx, 5)
 	evidence, found := types.GetEvidence(header, types.RelayEvidence)
 	assert.True(t, found)
@@ -22,6 +22,7 @@ func TestKeeper_GetSetClaim(t *testing.T) {
 	}
 	mockCtx := new(Ctx)
 	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys["pos"]).Return(ctx.KVStore(keys["pos"]))
 	mockCtx.On("PrevCtx", header.SessionBlockHeight).Return(ctx, nil)
 	err := keeper.SetClaim(mockCtx, claim)
 	assert.Nil(t, err)
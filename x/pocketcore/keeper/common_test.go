@@ -59,6 +59,8 @@ func makeTestCodec() *codec.Codec {
 	auth.RegisterCodec(cdc)
 	gov.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	appsTypes.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 
 	return cdc
@@ -172,6 +174,8 @@ func createTestInput(t *testing.T, isCheckTx bool) (sdk.Ctx, []nodesTypes.Valida
 	defaultPocketParams.SupportedBlockchains = []string{getTestSupportedBlockchain()}
 	keeper.SetParams(ctx, defaultPocketParams)
 	types.InitCache("data", "data", dbm.MemDBBackend, dbm.MemDBBackend, 100, 100)
+	// the test context is a session block, so persist the session snapshots like the BeginBlocker would
+	keeper.SetSessionSnapshots(ctx)
 	return ctx, vals, ap, accs, keeper, keys
 }
 
@@ -15,6 +15,9 @@ type Keeper struct {
 	appKeeper         types.AppsKeeper
 	Keybase           keys.Keybase
 	TmNode            client.Client
+	AutoUnjail        bool    // automatically unjail the self node once it is eligible
+	AutoCompound      bool    // automatically restake the balance of the self node above the CompoundReserve
+	CompoundReserve   sdk.Int // the balance of the self node that is never restaked
 	hostedBlockchains types.HostedBlockchains
 	Paramstore        sdk.Subspace
 	storeKey          sdk.StoreKey // Unexposed key to access store from sdk.Context
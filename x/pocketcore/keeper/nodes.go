@@ -14,6 +14,13 @@ func (k Keeper) GetAllNodes(ctx sdk.Ctx) []exported.ValidatorI {
 	return validators
 }
 
+// get all nodes staked for the chain from the world state
+func (k Keeper) GetNodesForChain(ctx sdk.Ctx, chain string) []exported.ValidatorI {
+	validators := k.posKeeper.GetNodesForChain(ctx, chain)
+	ctx.Logger().Info(fmt.Sprintf("GetNodesForChain(Chain = %s) = %v", chain, validators))
+	return validators
+}
+
 // get a node from the world state
 func (k Keeper) GetNode(ctx sdk.Ctx, address sdk.Address) (n exported.ValidatorI, found bool) {
 	ctx.Logger().Info(fmt.Sprintf("GetNode(Address = %v) \n", address.String()))
@@ -24,6 +31,11 @@ func (k Keeper) GetNode(ctx sdk.Ctx, address sdk.Address) (n exported.ValidatorI
 	return n, true
 }
 
+// get the address of the validator that signs with the address, a rotated consensus key resolves to its validator
+func (k Keeper) GetValidatorAddress(ctx sdk.Ctx, address sdk.Address) sdk.Address {
+	return k.posKeeper.ValidatorAddressFromConsensusAddress(ctx, address)
+}
+
 // self node is needed to verify that self node is part of a session
 func (k Keeper) GetSelfNode(ctx sdk.Ctx) (node exported.ValidatorI, er sdk.Error) {
 	// get the Keybase addr list
@@ -35,12 +35,24 @@ func (k Keeper) SupportedBlockchains(ctx sdk.Ctx) (res []string) {
 	return
 }
 
+func (k Keeper) StakeWeightedSessions(ctx sdk.Ctx) (res bool) {
+	k.Paramstore.Get(ctx, types.KeyStakeWeightedSessions, &res)
+	return
+}
+
+func (k Keeper) SessionNodeCountTiers(ctx sdk.Ctx) (res types.SessionNodeCountTiers) {
+	k.Paramstore.Get(ctx, types.KeySessionNodeCountTiers, &res)
+	return
+}
+
 func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 	return types.Params{
 		SessionNodeCount:      k.SessionNodeCount(ctx),
 		ClaimSubmissionWindow: k.ClaimSubmissionWindow(ctx),
 		SupportedBlockchains:  k.SupportedBlockchains(ctx),
 		ClaimExpiration:       k.ClaimExpiration(ctx),
+		StakeWeightedSessions: k.StakeWeightedSessions(ctx),
+		SessionNodeCountTiers: k.SessionNodeCountTiers(ctx),
 	}
 }
 
@@ -48,3 +60,13 @@ func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 func (k Keeper) SetParams(ctx sdk.Ctx, params types.Params) {
 	k.Paramstore.SetParamSet(ctx, &params)
 }
+
+// backfill the default of every param missing from the world state, for the params added after the chain started
+func (k Keeper) BackfillParams(ctx sdk.Ctx) {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !k.Paramstore.Has(ctx, pair.Key) {
+			k.Paramstore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/pokt-network/posmint/store/prefix"
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -51,6 +52,11 @@ func TestKeeper_SupportedBlockchains(t *testing.T) {
 	assert.Equal(t, []string{getTestSupportedBlockchain()}, supportedBlockchains)
 }
 
+func TestKeeper_StakeWeightedSessions(t *testing.T) {
+	ctx, _, _, _, keeper, _ := createTestInput(t, false)
+	assert.Equal(t, types.DefaultStakeWeightedSessions, keeper.StakeWeightedSessions(ctx))
+}
+
 func TestKeeper_GetParams(t *testing.T) {
 	ctx, _, _, _, k, _ := createTestInput(t, false)
 	p := types.Params{
@@ -58,6 +64,7 @@ func TestKeeper_GetParams(t *testing.T) {
 		ClaimSubmissionWindow: k.ClaimSubmissionWindow(ctx),
 		SupportedBlockchains:  k.SupportedBlockchains(ctx),
 		ClaimExpiration:       k.ClaimExpiration(ctx),
+		StakeWeightedSessions: k.StakeWeightedSessions(ctx),
 	}
 	paramz := k.GetParams(ctx)
 	assert.NotNil(t, paramz)
@@ -78,3 +85,16 @@ func TestKeeper_SetParams(t *testing.T) {
 	paramz := k.GetParams(ctx)
 	assert.Equal(t, paramz, p)
 }
+
+func TestKeeper_BackfillParams(t *testing.T) {
+	ctx, _, _, _, k, _ := createTestInput(t, false)
+	// a chain started before the session node count tiers param existed
+	store := prefix.NewStore(ctx.KVStore(sdk.ParamsKey), append([]byte(types.DefaultParamspace), '/'))
+	store.Delete(types.KeySessionNodeCountTiers)
+	assert.False(t, k.Paramstore.Has(ctx, types.KeySessionNodeCountTiers))
+	k.BackfillParams(ctx)
+	assert.True(t, k.Paramstore.Has(ctx, types.KeySessionNodeCountTiers))
+	assert.Equal(t, types.DefaultSessionNodeCountTiers, k.SessionNodeCountTiers(ctx))
+	// the params already set are kept
+	assert.Equal(t, []string{getTestSupportedBlockchain()}, k.SupportedBlockchains(ctx))
+}
@@ -17,8 +17,16 @@ import (
 )
 
 func BeginBlocker(ctx sdk.Ctx, _ abci.RequestBeginBlock, k Keeper) {
+	// backfill the defaults of the params added by the upgrade before they are read
+	k.BackfillParams(ctx)
 	// delete the proofs held within the world state for too long
 	k.DeleteExpiredClaims(ctx)
+	// persist the data needed to regenerate the sessions starting at this block
+	if k.IsSessionBlock(ctx) {
+		k.SetSessionSnapshots(ctx)
+	}
+	// delete the session snapshots that can no longer be claimed
+	k.DeleteExpiredSessionSnapshots(ctx)
 }
 
 // auto sends a proof transaction for the claim
@@ -28,8 +36,8 @@ func (k Keeper) SendProofTx(ctx sdk.Ctx, n client.Client, keybase keys.Keybase,
 		ctx.Logger().Error(fmt.Sprintf("an error occured retrieving the coinbase for the ProofTX:\n%v", err))
 		return
 	}
-	// get the self address
-	addr := kp.GetAddress()
+	// get the self address, the claims are stored under the validator address of a rotated consensus key
+	addr := k.GetValidatorAddress(ctx, sdk.Address(kp.GetAddress()))
 	// get all mature (waiting period has passed) claims for your address
 	claims, err := k.GetMatureClaims(ctx, addr)
 	if err != nil {
@@ -62,7 +70,7 @@ func (k Keeper) SendProofTx(ctx sdk.Ctx, n client.Client, keybase keys.Keybase,
 		leaf := pc.GetProof(claim.SessionHeader, claim.EvidenceType, index)
 		cousin := pc.GetProof(claim.SessionHeader, claim.EvidenceType, int64(cousinIndex))
 		// generate the auto txbuilder and clictx
-		txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, pc.MsgProofName, n, keybase, k)
+		txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, pc.MsgProofName, pc.PocketFeeMap[pc.MsgProofName], n, keybase, k)
 		if err != nil {
 			ctx.Logger().Error(fmt.Sprintf("an error occured in the transaction process of the ProofTX:\n%v", err))
 			return
@@ -81,7 +89,8 @@ func (k Keeper) ValidateProof(ctx sdk.Ctx, proof pc.MsgProof) (servicerAddr sdk.
 	if len(addrs) < 1 {
 		return nil, pc.MsgClaim{}, pc.NewEmptyAddressError(pc.ModuleName)
 	}
-	addr := addrs[0]
+	// the claims are stored under the validator address of a rotated consensus key
+	addr := k.GetValidatorAddress(ctx, addrs[0])
 	// get the claim for the address
 	claim, found := k.GetClaim(ctx, addr, proof.Leaf.SessionHeader(), proof.Leaf.EvidenceType())
 	// if the claim is not found for this claim
@@ -107,21 +116,27 @@ func (k Keeper) ValidateProof(ctx sdk.Ctx, proof pc.MsgProof) (servicerAddr sdk.
 	if !proof.MerkleProofs.Validate(claim.MerkleRoot, proof.Leaf, proof.Cousin, claim.TotalProofs) {
 		return nil, pc.MsgClaim{}, pc.NewInvalidMerkleVerifyError(pc.ModuleName)
 	}
-	// get the session context
-	sessionCtx, err := ctx.PrevCtx(claim.SessionBlockHeight)
-	if err != nil {
-		return nil, pc.MsgClaim{}, sdk.ErrInternal(err.Error())
-	}
-	// get the application
-	application, found := k.GetAppFromPublicKey(ctx, claim.ApplicationPubKey)
+	// retrieve the session snapshot persisted at the session block
+	snapshot, found := k.GetSessionSnapshot(ctx, claim.SessionBlockHeight, claim.Chain)
 	if !found {
-		return nil, pc.MsgClaim{}, pc.NewAppNotFoundError(pc.ModuleName)
+		return nil, pc.MsgClaim{}, pc.NewSessionSnapshotNotFoundError(pc.ModuleName)
+	}
+	// get the application at the time of the session
+	application, er := snapshot.App(claim.ApplicationPubKey)
+	if er != nil {
+		return nil, pc.MsgClaim{}, er
 	}
-	// validate the proof depending on the type of proof it is
-	er := proof.Leaf.Validate(application.GetChains(), int(k.SessionNodeCount(sessionCtx)), claim.SessionBlockHeight)
+	// validate the proof depending on the type of proof it is, with the session node count of the application
+	er = proof.Leaf.Validate([]string{claim.Chain}, int(application.SessionNodeCount), claim.SessionBlockHeight)
 	if er != nil {
 		return nil, pc.MsgClaim{}, er
 	}
+	// timeout evidence must come from the session and may only be executed once
+	if timeout, ok := proof.Leaf.(pc.ChallengeProofTimeout); ok {
+		if er := k.ValidateTimeout(ctx, timeout, claim.SessionHeader); er != nil {
+			return nil, pc.MsgClaim{}, er
+		}
+	}
 	// return the needed info to the handler
 	return addr, claim, nil
 }
@@ -142,13 +157,30 @@ func (k Keeper) ExecuteProof(ctx sdk.Ctx, proof pc.MsgProof, claim pc.MsgClaim)
 		if err != nil {
 			return sdk.ErrInvalidPubKey(err.Error())
 		}
-		k.BurnCoinsForChallenges(ctx, claim.TotalProofs, sdk.Address(pubKey.Address()))
+		k.BurnCoinsForChallenges(ctx, claim.TotalProofs, k.GetValidatorAddress(ctx, sdk.Address(pubKey.Address())))
 		err = k.DeleteClaim(ctx, claim.FromAddress, claim.SessionHeader, pc.ChallengeEvidence)
 		if err != nil {
 			return sdk.ErrInternal(err.Error())
 		}
 		// small reward for the challenge proof invalid data
 		k.AwardCoinsForRelays(ctx, claim.TotalProofs/100, claim.FromAddress)
+	case pc.ChallengeProofTimeout:
+		ctx.Logger().Info(fmt.Sprintf("burning coins from %s, for %d valid timeouts", claim.FromAddress.String(), claim.TotalProofs))
+		timeout := proof.Leaf.(pc.ChallengeProofTimeout)
+		servicerAddr, er := timeout.ServicerAddress()
+		if er != nil {
+			return er
+		}
+		servicerAddr = k.GetValidatorAddress(ctx, servicerAddr)
+		k.BurnCoinsForChallenges(ctx, claim.TotalProofs, servicerAddr)
+		// the burn covers every leaf of the claim: mark all of the timeouts of the servicer in the session as executed
+		k.SetTimeout(ctx, claim.SessionHeader, servicerAddr, timeout)
+		err := k.DeleteClaim(ctx, claim.FromAddress, claim.SessionHeader, pc.TimeoutEvidence)
+		if err != nil {
+			return sdk.ErrInternal(err.Error())
+		}
+		// small reward for the timeout proof
+		k.AwardCoinsForRelays(ctx, claim.TotalProofs/100, claim.FromAddress)
 	}
 	return nil
 }
@@ -205,7 +237,7 @@ func (k Keeper) getPseudorandomIndex(ctx sdk.Ctx, totalRelays int64, header pc.S
 }
 
 // todo exchanged password for pk, move or unify
-func newTxBuilderAndCliCtx(ctx sdk.Ctx, msgType string, n client.Client, keybase keys.Keybase, k Keeper) (txBuilder auth.TxBuilder, cliCtx util.CLIContext, err error) {
+func newTxBuilderAndCliCtx(ctx sdk.Ctx, msgType string, msgFee int64, n client.Client, keybase keys.Keybase, k Keeper) (txBuilder auth.TxBuilder, cliCtx util.CLIContext, err error) {
 	// get the coinbase, as it is the sender of the automatic message
 	kp, err := keybase.GetCoinbase()
 	if err != nil {
@@ -234,7 +266,7 @@ func newTxBuilderAndCliCtx(ctx sdk.Ctx, msgType string, n client.Client, keybase
 		return txBuilder, cliCtx, err
 	}
 	// check the fee amount
-	fee := sdk.NewInt(pc.PocketFeeMap[msgType])
+	fee := sdk.NewInt(msgFee)
 	if account.GetCoins().AmountOf(k.posKeeper.StakeDenom(ctx)).LTE(fee) {
 		ctx.Logger().Error(fmt.Sprintf("insufficient funds for the auto %s transaction: the fee needed is %v ", msgType, fee))
 	}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	nodesKeeper "github.com/pokt-network/pocket-core/x/nodes/keeper"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +17,8 @@ func TestKeeper_ValidateProof(t *testing.T) { // happy path only todo
 	if !found {
 		t.Fatalf("Set evidence not found")
 	}
+	// the session snapshot of the claimed session
+	keeper.SetSessionSnapshots(ctx.WithBlockHeight(header.SessionBlockHeight))
 	root := evidence.GenerateMerkleRoot()
 	totalRelays := types.GetTotalProofs(header, types.RelayEvidence)
 	assert.Equal(t, totalRelays, int64(5))
@@ -29,6 +32,7 @@ func TestKeeper_ValidateProof(t *testing.T) { // happy path only todo
 	}
 	mockCtx := &Ctx{}
 	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys["pos"]).Return(ctx.KVStore(keys["pos"]))
 	mockCtx.On("KVStore", keys[sdk.ParamsKey.Name()]).Return(ctx.KVStore(keys[sdk.ParamsKey.Name()]))
 	mockCtx.On("KVStore", keys[appsTypes.StoreKey]).Return(ctx.KVStore(keys[appsTypes.StoreKey]))
 	mockCtx.On("Logger").Return(ctx.Logger())
@@ -60,6 +64,61 @@ func TestKeeper_ValidateProof(t *testing.T) { // happy path only todo
 	}
 }
 
+func TestKeeper_ClaimAndProveRotatedConsensusKey(t *testing.T) {
+	ctx, _, _, _, keeper, keys := createTestInput(t, false)
+	types.ClearEvidence()
+	types.ClearSessionCache()
+	npk, header, _, _ := simulateRelays(t, keeper, &ctx, 5)
+	evidence, found := types.GetEvidence(header, types.RelayEvidence)
+	assert.True(t, found)
+	keeper.SetSessionSnapshots(ctx.WithBlockHeight(header.SessionBlockHeight))
+	mockCtx := &Ctx{}
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys["pos"]).Return(ctx.KVStore(keys["pos"]))
+	mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+	mockCtx.On("KVStore", keys["application"]).Return(ctx.KVStore(keys["application"]))
+	mockCtx.On("BlockHeight").Return(header.SessionBlockHeight)
+	mockCtx.On("Logger").Return(ctx.Logger())
+	mockCtx.On("PrevCtx", header.SessionBlockHeight).Return(ctx, nil)
+	mockCtx.On("PrevCtx", header.SessionBlockHeight+keeper.ClaimSubmissionWindow(ctx)*keeper.SessionFrequency(ctx)).Return(ctx, nil)
+	session, err := keeper.GetSession(mockCtx, header)
+	assert.Nil(t, err)
+	// a session node rotates its consensus key to the key that serviced the relays after the session block
+	nk := keeper.posKeeper.(nodesKeeper.Keeper)
+	validator, found := nk.GetValidator(ctx, session.SessionNodes[0].GetAddress())
+	assert.True(t, found)
+	nk.RotateConsensusKey(ctx, validator, npk)
+	// the claim signed with the new key is validated against the validator of the session
+	claim := types.MsgClaim{
+		SessionHeader: header,
+		MerkleRoot:    evidence.GenerateMerkleRoot(),
+		TotalProofs:   5,
+		FromAddress:   sdk.Address(npk.Address()),
+		EvidenceType:  types.RelayEvidence,
+	}
+	assert.Nil(t, keeper.ValidateClaim(mockCtx, claim))
+	assert.Nil(t, keeper.SetClaim(mockCtx, claim))
+	// and stored under the validator address
+	_, found = keeper.GetClaim(mockCtx, validator.Address, header, types.RelayEvidence)
+	assert.True(t, found)
+	_, found = keeper.GetClaim(mockCtx, sdk.Address(npk.Address()), header, types.RelayEvidence)
+	assert.False(t, found)
+	// the proof signed with the new key finds the claim of the validator
+	index, er := keeper.getPseudorandomIndex(mockCtx, claim.TotalProofs, header)
+	assert.Nil(t, er)
+	merkleProofs, cousinIndex := evidence.GenerateMerkleProof(int(index))
+	proofMsg := types.MsgProof{
+		MerkleProofs: merkleProofs,
+		Leaf:         types.GetProof(header, types.RelayEvidence, index).(types.RelayProof),
+		Cousin:       types.GetProof(header, types.RelayEvidence, int64(cousinIndex)).(types.RelayProof),
+	}
+	addr, proven, err := keeper.ValidateProof(mockCtx, proofMsg)
+	assert.Nil(t, err)
+	assert.Equal(t, validator.Address, addr)
+	assert.Equal(t, validator.Address, proven.FromAddress)
+	types.ClearSessionCache()
+}
+
 func TestKeeper_GetPsuedorandomIndex(t *testing.T) {
 	var totalRelays []int = []int{10, 100, 10000000}
 	for _, relays := range totalRelays {
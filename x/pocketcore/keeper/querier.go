@@ -27,6 +27,8 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryDispatch(ctx, req, k)
 		case types.QueryChallenge:
 			return queryChallenge(ctx, req, k)
+		case types.QueryTimeout:
+			return queryTimeout(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -50,6 +52,23 @@ func queryChallenge(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.E
 	return res, nil
 }
 
+func queryTimeout(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTimeoutParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	response, er := k.HandleTimeout(ctx, params.Timeout)
+	if er != nil {
+		return nil, er
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, response)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
 func queryRelay(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
 	var params types.QueryRelayParams
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
@@ -115,10 +134,13 @@ func queryReceipt(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Err
 		et = types.RelayEvidence
 	case "challenge":
 		et = types.ChallengeEvidence
+	case "timeout":
+		et = types.TimeoutEvidence
 	default:
-		return nil, sdk.ErrInternal("type in the receipt query is not recognized: (relay or challenge)")
+		return nil, sdk.ErrInternal("type in the receipt query is not recognized: (relay, challenge or timeout)")
 	}
-	evidence, _ := k.GetReceipt(ctx, params.Address, params.Header, et)
+	// the receipts are stored under the validator address of a rotated consensus key
+	evidence, _ := k.GetReceipt(ctx, k.GetValidatorAddress(ctx, params.Address), params.Header, et)
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, evidence)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
@@ -133,7 +155,7 @@ func queryReceipts(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Er
 	if err != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
-	receipts, err := k.GetReceipts(ctx, params.Address)
+	receipts, err := k.GetReceipts(ctx, k.GetValidatorAddress(ctx, params.Address))
 	if err != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("an error occured retrieving the receipts: %s", err))
 	}
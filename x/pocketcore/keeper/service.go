@@ -11,8 +11,6 @@ import (
 func (k Keeper) HandleRelay(ctx sdk.Ctx, relay pc.Relay) (*pc.RelayResponse, sdk.Error) {
 	// get the latest session block height because this relay will correspond with the latest session
 	sessionBlockHeight := k.GetLatestSessionBlockHeight(ctx)
-	// retrieve all service nodes available from world state to do session generation (the session data is needed to service)
-	allNodes := k.GetAllNodes(ctx)
 	// get self node (your validator) from the current state
 	selfNode, err := k.GetSelfNode(ctx)
 	if err != nil {
@@ -20,19 +18,20 @@ func (k Keeper) HandleRelay(ctx sdk.Ctx, relay pc.Relay) (*pc.RelayResponse, sdk
 	}
 	// retrieve the nonNative blockchains your node is hosting
 	hostedBlockchains := k.GetHostedBlockchains()
-	// get the application that staked on behalf of the client
-	app, found := k.GetAppFromPublicKey(ctx, relay.Proof.Token.ApplicationPublicKey)
+	// retrieve the session snapshot to do session generation (the session data is needed to service)
+	snapshot, found := k.GetSessionSnapshot(ctx, sessionBlockHeight, relay.Proof.Blockchain)
 	if !found {
-		return nil, pc.NewAppNotFoundError(pc.ModuleName)
+		return nil, pc.NewSessionSnapshotNotFoundError(pc.ModuleName)
 	}
-	// get the session context
-	sessionCtx, er := ctx.PrevCtx(sessionBlockHeight)
-	if er != nil {
-		return nil, sdk.ErrInternal(er.Error())
+	// get the session of the application at the session block, so the relay is validated against the same session
+	// nodes and max relays as the claim
+	app, err := snapshot.App(relay.Proof.Token.ApplicationPublicKey)
+	if err != nil {
+		return nil, err
 	}
 	// ensure the validity of the relay
-	if err := relay.Validate(ctx, selfNode, hostedBlockchains, sessionBlockHeight, int(k.SessionNodeCount(sessionCtx)), allNodes, app); err != nil {
-		ctx.Logger().Error(fmt.Errorf("could not validate for %v, %v, %v %v, %v, %v \n", selfNode, hostedBlockchains, sessionBlockHeight, int(k.SessionNodeCount(sessionCtx)), allNodes, app).Error())
+	if err := relay.Validate(ctx, selfNode, hostedBlockchains, sessionBlockHeight, snapshot, app); err != nil {
+		ctx.Logger().Error(fmt.Errorf("could not validate for %v, %v, %v, %v, %v \n", selfNode, hostedBlockchains, sessionBlockHeight, snapshot.Nodes, app).Error())
 		return nil, err
 	}
 	// store the proof before execution, because the proof corresponds to the previous relay
@@ -69,37 +68,30 @@ func (k Keeper) HandleChallenge(ctx sdk.Ctx, challenge pc.ChallengeProofInvalidD
 		return nil, err
 	}
 	sessionBlkHeight := k.GetLatestSessionBlockHeight(ctx)
-	// get the session context
-	sessionCtx, er := ctx.PrevCtx(sessionBlkHeight)
-	if er != nil {
-		return nil, sdk.ErrInternal(er.Error())
-	}
-	// get the application that staked on behalf of the client
-	app, found := k.GetAppFromPublicKey(ctx, challenge.MinorityResponse.Proof.Token.ApplicationPublicKey)
-	if !found {
-		return nil, pc.NewAppNotFoundError(pc.ModuleName)
-	}
 	// generate header
 	header := pc.SessionHeader{
-		ApplicationPubKey:  app.GetPublicKey().RawString(),
+		ApplicationPubKey:  challenge.MinorityResponse.Proof.Token.ApplicationPublicKey,
 		Chain:              challenge.MinorityResponse.Proof.Blockchain,
-		SessionBlockHeight: sessionCtx.BlockHeight(),
+		SessionBlockHeight: sessionBlkHeight,
 	}
-	// check cache
-	session, found := pc.GetSession(header)
-	// if not found generate the session
+	// retrieve the session snapshot
+	snapshot, found := k.GetSessionSnapshot(ctx, sessionBlkHeight, header.Chain)
 	if !found {
-		var err sdk.Error
-		nodes := k.GetAllNodes(ctx)
-		session, err = pc.NewSession(header, pc.BlockHash(sessionCtx), nodes, int(k.SessionNodeCount(sessionCtx)))
-		if err != nil {
-			return nil, err
-		}
-		// add to cache
-		pc.SetSession(session)
+		return nil, pc.NewSessionSnapshotNotFoundError(pc.ModuleName)
+	}
+	// get the application that staked on behalf of the client at the session block
+	app, err := snapshot.App(header.ApplicationPubKey)
+	if err != nil {
+		return nil, err
+	}
+	sessionNodeCount := int(app.SessionNodeCount)
+	// retrieve the session from the cache or the session snapshot
+	session, err := snapshot.Session(header)
+	if err != nil {
+		return nil, err
 	}
 	// validate the challenge
-	err = challenge.ValidateLocal(app.GetMaxRelays().Int64(), sessionBlkHeight, app.GetChains(), int(k.SessionNodeCount(sessionCtx)), session.SessionNodes, selfNode.GetAddress())
+	err = challenge.ValidateLocal(app.MaxRelays.Int64(), sessionBlkHeight, []string{header.Chain}, sessionNodeCount, session.SessionNodes, selfNode.GetAddress())
 	if err != nil {
 		return nil, err
 	}
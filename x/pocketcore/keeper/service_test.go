@@ -39,6 +39,12 @@ func TestKeeper_HandleRelay(t *testing.T) {
 	// set the vals from the data
 	ak.SetApplication(ctx, app)
 	ak.SetStakedApplication(ctx, app)
+	// the application is staked at the session block
+	keeper.SetSessionSnapshots(ctx)
+	// edits of the application after the session block do not apply to the relays of the session
+	edited := app
+	edited.Chains = []string{getRandomPubKey().RawString()}
+	ak.SetApplication(ctx, edited)
 	kp, _ := keeper.Keybase.GetCoinbase()
 	npk := kp.PublicKey
 	nodePubKey := npk.RawString()
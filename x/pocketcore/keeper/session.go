@@ -1,33 +1,34 @@
 package keeper
 
 import (
+	"fmt"
+	"github.com/pokt-network/pocket-core/x/apps/exported"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	sdk "github.com/pokt-network/posmint/types"
 )
 
+// dispatch the session of the header, an empty session block height dispatches the latest session
 func (k Keeper) Dispatch(ctx sdk.Ctx, header types.SessionHeader) (*types.DispatchResponse, sdk.Error) {
 	latestSessionBlockHeight := k.GetLatestSessionBlockHeight(ctx)
-	header.SessionBlockHeight = latestSessionBlockHeight
+	if header.SessionBlockHeight == 0 {
+		header.SessionBlockHeight = latestSessionBlockHeight
+	}
 	err := header.ValidateHeader()
 	if err != nil {
 		return nil, err
 	}
-	sessionCtx, er := ctx.PrevCtx(header.SessionBlockHeight)
-	if er != nil {
-		return nil, sdk.ErrInternal(er.Error())
+	// sessions beyond the current one can't be generated
+	if header.SessionBlockHeight > latestSessionBlockHeight {
+		return nil, types.NewSessionHeightInFutureError(types.ModuleName)
 	}
-	// check cache
-	session, found := types.GetSession(header)
-	// if not found generate the session
-	if !found {
-		var err sdk.Error
-		nodes := k.GetAllNodes(ctx)
-		session, err = types.NewSession(header, types.BlockHash(sessionCtx), nodes, int(k.SessionNodeCount(sessionCtx)))
-		if err != nil {
-			return nil, err
-		}
-		// add to cache
-		types.SetSession(session)
+	// the height must be the start of a session
+	if (header.SessionBlockHeight-1)%k.posKeeper.SessionBlockFrequency(ctx) != 0 {
+		return nil, types.NewInvalidBlockHeightError(types.ModuleName)
+	}
+	// retrieve the session from the cache or the session snapshot
+	session, err := k.GetSession(ctx, header)
+	if err != nil {
+		return nil, err
 	}
 	return &types.DispatchResponse{Session: session, BlockHeight: ctx.BlockHeight()}, nil
 }
@@ -59,3 +60,91 @@ func (k Keeper) IsPocketSupportedBlockchain(ctx sdk.Ctx, chain string) bool {
 	}
 	return false
 }
+
+// persist the session snapshot of every supported chain with staked nodes, called once per session block
+func (k Keeper) SetSessionSnapshots(ctx sdk.Ctx) {
+	// the session context of the current block (falls back to the consensus hash when there is no previous block)
+	sessionCtx, err := ctx.PrevCtx(ctx.BlockHeight())
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("unable to create the session snapshots at height %d: %s", ctx.BlockHeight(), err.Error()))
+		return
+	}
+	blockHash := types.BlockHash(sessionCtx)
+	sessionNodeCount := k.SessionNodeCount(ctx)
+	sessionNodeCountTiers := k.SessionNodeCountTiers(ctx)
+	stakeWeighted := k.StakeWeightedSessions(ctx)
+	// group the staked applications by chain
+	appsByChain := make(map[string][]exported.ApplicationI)
+	for _, app := range k.appKeeper.AllApplications(ctx) {
+		if !app.IsStaked() {
+			continue
+		}
+		for _, chain := range app.GetChains() {
+			appsByChain[chain] = append(appsByChain[chain], app)
+		}
+	}
+	// only the supported chains with staked nodes have sessions, the nodes are read from the chain index
+	for _, chain := range k.SupportedBlockchains(ctx) {
+		nodes := k.GetNodesForChain(ctx, chain)
+		if len(nodes) == 0 {
+			continue
+		}
+		k.SetSessionSnapshot(ctx, types.NewSessionSnapshot(ctx.BlockHeight(), chain, blockHash, nodes, appsByChain[chain],
+			sessionNodeCount, sessionNodeCountTiers, stakeWeighted))
+	}
+}
+
+// set the session snapshot in the world state
+func (k Keeper) SetSessionSnapshot(ctx sdk.Ctx, snapshot types.SessionSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(snapshot)
+	store.Set(types.KeyForSessionSnapshot(snapshot.SessionBlockHeight, snapshot.Chain), bz)
+}
+
+// retrieve the session snapshot for the chain at the session block height
+func (k Keeper) GetSessionSnapshot(ctx sdk.Ctx, sessionBlockHeight int64, chain string) (snapshot types.SessionSnapshot, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	res := store.Get(types.KeyForSessionSnapshot(sessionBlockHeight, chain))
+	if res == nil {
+		return types.SessionSnapshot{}, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(res, &snapshot)
+	return snapshot, true
+}
+
+// retrieve the application of a session header as it was at the session block
+func (k Keeper) GetSessionApp(ctx sdk.Ctx, header types.SessionHeader) (types.SessionApp, sdk.Error) {
+	snapshot, found := k.GetSessionSnapshot(ctx, header.SessionBlockHeight, header.Chain)
+	if !found {
+		return types.SessionApp{}, types.NewSessionSnapshotNotFoundError(types.ModuleName)
+	}
+	return snapshot.App(header.ApplicationPubKey)
+}
+
+// delete the session snapshots that can no longer be referenced by a claim
+func (k Keeper) DeleteExpiredSessionSnapshots(ctx sdk.Ctx) {
+	// claims expire after this many blocks, so the snapshots of older sessions are never read again
+	expiredHeight := ctx.BlockHeight() - k.ClaimExpiration(ctx)*k.SessionFrequency(ctx)
+	if expiredHeight <= 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.SessionSnapshotKey, types.KeyForSessionSnapshots(expiredHeight+1))
+	defer iterator.Close()
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// retrieve the session from the cache or the persisted session snapshot
+func (k Keeper) GetSession(ctx sdk.Ctx, header types.SessionHeader) (types.Session, sdk.Error) {
+	snapshot, found := k.GetSessionSnapshot(ctx, header.SessionBlockHeight, header.Chain)
+	if !found {
+		return types.Session{}, types.NewSessionSnapshotNotFoundError(types.ModuleName)
+	}
+	return snapshot.Session(header)
+}
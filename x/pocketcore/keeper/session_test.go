@@ -1,15 +1,17 @@
 package keeper
 
 import (
+	appsKeeper "github.com/pokt-network/pocket-core/x/apps/keeper"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
 
 func TestKeeper_Dispatch(t *testing.T) {
 	ctx, _, _, _, keeper, keys := createTestInput(t, false)
-	appPrivateKey := getRandomPrivateKey()
-	appPubKey := appPrivateKey.PublicKey().RawString()
+	// sessions are only dispatched for the applications of the session snapshot
+	appPubKey := getTestApplication().PublicKey.RawString()
 	ethereum, err := types.NonNativeChain{
 		Ticker:  "eth",
 		Netid:   "4",
@@ -57,6 +59,51 @@ func TestKeeper_Dispatch(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestKeeper_DispatchSessionHeight(t *testing.T) {
+	ctx, _, _, _, keeper, keys := createTestInput(t, false)
+	types.ClearSessionCache()
+	frequency := keeper.SessionFrequency(ctx)
+	pastSessionHeight := ctx.BlockHeight() - frequency
+	// persist the snapshot of a past session block
+	keeper.SetSessionSnapshots(ctx.WithBlockHeight(pastSessionHeight))
+	mockCtx := new(Ctx)
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys["pos"]).Return(ctx.KVStore(keys["pos"]))
+	mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+	mockCtx.On("KVStore", keys["application"]).Return(ctx.KVStore(keys["application"]))
+	mockCtx.On("PrevCtx", ctx.BlockHeight()).Return(ctx, nil)
+	mockCtx.On("PrevCtx", pastSessionHeight).Return(ctx, nil)
+	mockCtx.On("BlockHeight").Return(ctx.BlockHeight())
+	mockCtx.On("Logger").Return(ctx.Logger())
+	header := types.SessionHeader{
+		ApplicationPubKey:  getTestApplication().PublicKey.RawString(),
+		Chain:              getTestSupportedBlockchain(),
+		SessionBlockHeight: 0,
+	}
+	// an empty height dispatches the latest session
+	res, err := keeper.Dispatch(mockCtx, header)
+	assert.Nil(t, err)
+	assert.Equal(t, ctx.BlockHeight(), res.Session.SessionBlockHeight)
+	// a past session height dispatches the past session
+	header.SessionBlockHeight = pastSessionHeight
+	res, err = keeper.Dispatch(mockCtx, header)
+	assert.Nil(t, err)
+	assert.Equal(t, header, res.Session.SessionHeader)
+	assert.Equal(t, ctx.BlockHeight(), res.BlockHeight)
+	// a height that isn't a session block is rejected
+	header.SessionBlockHeight = pastSessionHeight + 1
+	_, err = keeper.Dispatch(mockCtx, header)
+	assert.Equal(t, types.NewInvalidBlockHeightError(types.ModuleName), err)
+	// a height beyond the current session is rejected
+	header.SessionBlockHeight = ctx.BlockHeight() + frequency
+	_, err = keeper.Dispatch(mockCtx, header)
+	assert.Equal(t, types.NewSessionHeightInFutureError(types.ModuleName), err)
+	// a past session without a snapshot can't be dispatched
+	header.SessionBlockHeight = pastSessionHeight - frequency
+	_, err = keeper.Dispatch(mockCtx, header)
+	assert.Equal(t, types.NewSessionSnapshotNotFoundError(types.ModuleName), err)
+}
+
 func TestKeeper_IsSessionBlock(t *testing.T) {
 	notSessionContext, _, _, _, keeper, _ := createTestInput(t, false)
 	assert.False(t, keeper.IsSessionBlock(notSessionContext.WithBlockHeight(977)))
@@ -73,3 +120,221 @@ func TestKeeper_IsPocketSupportedBlockchain(t *testing.T) {
 	assert.True(t, keeper.IsPocketSupportedBlockchain(ctx, "ethereum"))
 	assert.False(t, keeper.IsPocketSupportedBlockchain(ctx, notSB))
 }
+
+func TestKeeper_DispatchStakeWeighted(t *testing.T) {
+	ctx, _, _, _, keeper, keys := createTestInput(t, false)
+	params := keeper.GetParams(ctx)
+	params.StakeWeightedSessions = true
+	keeper.SetParams(ctx, params)
+	// the param change only takes effect in the snapshot of the session block
+	keeper.SetSessionSnapshots(ctx)
+	types.ClearSessionCache()
+	header := types.SessionHeader{
+		ApplicationPubKey:  getTestApplication().PublicKey.RawString(),
+		Chain:              getTestSupportedBlockchain(),
+		SessionBlockHeight: 976,
+	}
+	mockCtx := new(Ctx)
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys["pos"]).Return(ctx.KVStore(keys["pos"]))
+	mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+	mockCtx.On("KVStore", keys["application"]).Return(ctx.KVStore(keys["application"]))
+	mockCtx.On("PrevCtx", header.SessionBlockHeight).Return(ctx, nil)
+	mockCtx.On("BlockHeight").Return(ctx.BlockHeight())
+	mockCtx.On("Logger").Return(ctx.Logger())
+	res, err := keeper.Dispatch(mockCtx, header)
+	assert.Nil(t, err)
+	// the dispatched session is the stake weighted selection
+	sessionKey, err := types.NewSessionKey(header.ApplicationPubKey, header.Chain, types.BlockHash(ctx.(sdk.Context)))
+	assert.Nil(t, err)
+	expected, err := types.NewStakeWeightedSessionNodes(header.Chain, sessionKey, keeper.GetAllNodes(ctx), 5, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, res.Session.SessionNodes)
+	// claim validation regenerates the same session
+	types.ClearSessionCache()
+	for _, node := range res.Session.SessionNodes {
+		claim := types.MsgClaim{
+			SessionHeader: header,
+			TotalProofs:   10,
+			FromAddress:   node.GetAddress(),
+			EvidenceType:  types.RelayEvidence,
+		}
+		assert.Nil(t, keeper.ValidateClaim(mockCtx, claim))
+	}
+}
+
+func TestKeeper_DispatchExcludedNodes(t *testing.T) {
+	ctx, _, _, _, keeper, keys := createTestInput(t, false)
+	// leave a node out of the session to replace the excluded one
+	params := keeper.GetParams(ctx)
+	params.SessionNodeCount = 4
+	keeper.SetParams(ctx, params)
+	keeper.SetSessionSnapshots(ctx)
+	types.ClearSessionCache()
+	header := types.SessionHeader{
+		ApplicationPubKey:  getTestApplication().PublicKey.RawString(),
+		Chain:              getTestSupportedBlockchain(),
+		SessionBlockHeight: 976,
+	}
+	mockCtx := new(Ctx)
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys["pos"]).Return(ctx.KVStore(keys["pos"]))
+	mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+	mockCtx.On("KVStore", keys["application"]).Return(ctx.KVStore(keys["application"]))
+	mockCtx.On("PrevCtx", header.SessionBlockHeight).Return(ctx, nil)
+	mockCtx.On("BlockHeight").Return(ctx.BlockHeight())
+	mockCtx.On("Logger").Return(ctx.Logger())
+	res, err := keeper.Dispatch(mockCtx, header)
+	assert.Nil(t, err)
+	excluded := res.Session.SessionNodes[0]
+	// the application excludes a node of its session
+	app := getTestApplication()
+	app.ExcludedNodes = []sdk.Address{excluded.GetAddress()}
+	keeper.appKeeper.(appsKeeper.Keeper).SetApplication(ctx, app)
+	types.ClearSessionCache()
+	// the session keeps the application of the session block
+	res, err = keeper.Dispatch(mockCtx, header)
+	assert.Nil(t, err)
+	assert.True(t, res.Session.SessionNodes.Contains(excluded))
+	// the exclusions apply from the next session snapshot
+	keeper.SetSessionSnapshots(ctx)
+	types.ClearSessionCache()
+	res, err = keeper.Dispatch(mockCtx, header)
+	assert.Nil(t, err)
+	assert.Len(t, res.Session.SessionNodes, 4)
+	assert.False(t, res.Session.SessionNodes.Contains(excluded))
+	// claim validation applies the same exclusions
+	types.ClearSessionCache()
+	for _, node := range res.Session.SessionNodes {
+		claim := types.MsgClaim{
+			SessionHeader: header,
+			TotalProofs:   10,
+			FromAddress:   node.GetAddress(),
+			EvidenceType:  types.RelayEvidence,
+		}
+		assert.Nil(t, keeper.ValidateClaim(mockCtx, claim))
+	}
+	claim := types.MsgClaim{
+		SessionHeader: header,
+		TotalProofs:   10,
+		FromAddress:   excluded.GetAddress(),
+		EvidenceType:  types.RelayEvidence,
+	}
+	assert.NotNil(t, keeper.ValidateClaim(mockCtx, claim))
+	types.ClearSessionCache()
+}
+
+func TestKeeper_DispatchSessionNodeCountTiers(t *testing.T) {
+	ctx, _, _, _, keeper, keys := createTestInput(t, false)
+	// the test application stakes enough for the second tier only
+	params := keeper.GetParams(ctx)
+	params.SessionNodeCount = 2
+	params.SessionNodeCountTiers = types.SessionNodeCountTiers{
+		{MinStake: sdk.NewInt(1000), SessionNodeCount: 3},
+		{MinStake: sdk.NewInt(100000000), SessionNodeCount: 4},
+	}
+	keeper.SetParams(ctx, params)
+	keeper.SetSessionSnapshots(ctx)
+	types.ClearSessionCache()
+	header := types.SessionHeader{
+		ApplicationPubKey:  getTestApplication().PublicKey.RawString(),
+		Chain:              getTestSupportedBlockchain(),
+		SessionBlockHeight: 976,
+	}
+	mockCtx := new(Ctx)
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys["pos"]).Return(ctx.KVStore(keys["pos"]))
+	mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+	mockCtx.On("KVStore", keys["application"]).Return(ctx.KVStore(keys["application"]))
+	mockCtx.On("PrevCtx", header.SessionBlockHeight).Return(ctx, nil)
+	mockCtx.On("BlockHeight").Return(ctx.BlockHeight())
+	mockCtx.On("Logger").Return(ctx.Logger())
+	res, err := keeper.Dispatch(mockCtx, header)
+	assert.Nil(t, err)
+	assert.Len(t, res.Session.SessionNodes, 3)
+	// claim validation uses the same session node count
+	types.ClearSessionCache()
+	for _, node := range res.Session.SessionNodes {
+		claim := types.MsgClaim{
+			SessionHeader: header,
+			TotalProofs:   10,
+			FromAddress:   node.GetAddress(),
+			EvidenceType:  types.RelayEvidence,
+		}
+		assert.Nil(t, keeper.ValidateClaim(mockCtx, claim))
+	}
+	// an application not staked at the session block has no session
+	header.ApplicationPubKey = getRandomPubKey().RawString()
+	_, err = keeper.Dispatch(mockCtx, header)
+	assert.Equal(t, types.NewAppNotFoundError(types.ModuleName), err)
+	types.ClearSessionCache()
+}
+
+func TestKeeper_SessionSnapshots(t *testing.T) {
+	ctx, vals, _, _, keeper, keys := createTestInput(t, false)
+	types.ClearSessionCache()
+	header := types.SessionHeader{
+		ApplicationPubKey:  getTestApplication().PublicKey.RawString(),
+		Chain:              getTestSupportedBlockchain(),
+		SessionBlockHeight: 976,
+	}
+	// the snapshot holds the sessions of the applications and the block hash of the session block
+	snapshot, found := keeper.GetSessionSnapshot(ctx, header.SessionBlockHeight, header.Chain)
+	assert.True(t, found)
+	assert.Equal(t, header.Chain, snapshot.Chain)
+	assert.Equal(t, types.BlockHash(ctx.(sdk.Context)), snapshot.BlockHash)
+	app, err := snapshot.App(header.ApplicationPubKey)
+	assert.Nil(t, err)
+	assert.Equal(t, keeper.SessionNodeCount(ctx), app.SessionNodeCount)
+	assert.Len(t, app.SessionNodes, int(app.SessionNodeCount))
+	assert.Equal(t, getTestApplication().GetChainMaxRelays(header.Chain), app.MaxRelays)
+	// and only the nodes selected for the sessions
+	selected := make(map[string]struct{})
+	for _, a := range snapshot.Apps {
+		for _, addr := range a.SessionNodes {
+			selected[addr.String()] = struct{}{}
+		}
+	}
+	assert.Len(t, snapshot.Nodes, len(selected))
+	for _, node := range snapshot.Nodes {
+		assert.Contains(t, selected, node.GetAddress().String())
+	}
+	_, found = keeper.GetSessionSnapshot(ctx, header.SessionBlockHeight+1, header.Chain)
+	assert.False(t, found)
+	expected, err := keeper.GetSession(ctx, header)
+	assert.Nil(t, err)
+	// changing the world state after the session block does not change the regenerated session
+	keeper.posKeeper.JailValidator(ctx, vals[0].Address)
+	assert.Len(t, keeper.GetNodesForChain(ctx, header.Chain), len(vals)-1)
+	types.ClearSessionCache()
+	mockCtx := new(Ctx)
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys["pos"]).Return(ctx.KVStore(keys["pos"]))
+	mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+	mockCtx.On("KVStore", keys["application"]).Return(ctx.KVStore(keys["application"]))
+	mockCtx.On("PrevCtx", header.SessionBlockHeight).Return(ctx, nil)
+	mockCtx.On("BlockHeight").Return(ctx.BlockHeight())
+	mockCtx.On("Logger").Return(ctx.Logger())
+	res, err := keeper.Dispatch(mockCtx, header)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, res.Session)
+	// snapshots are deleted once no claim can reference them
+	expiredCtx := ctx.WithBlockHeight(header.SessionBlockHeight + keeper.ClaimExpiration(ctx)*keeper.SessionFrequency(ctx) - 1)
+	keeper.DeleteExpiredSessionSnapshots(expiredCtx)
+	_, found = keeper.GetSessionSnapshot(ctx, header.SessionBlockHeight, header.Chain)
+	assert.True(t, found)
+	expiredCtx = ctx.WithBlockHeight(header.SessionBlockHeight + keeper.ClaimExpiration(ctx)*keeper.SessionFrequency(ctx))
+	keeper.DeleteExpiredSessionSnapshots(expiredCtx)
+	_, found = keeper.GetSessionSnapshot(ctx, header.SessionBlockHeight, header.Chain)
+	assert.False(t, found)
+	_, err = keeper.GetSession(ctx, header)
+	assert.Equal(t, types.NewSessionSnapshotNotFoundError(types.ModuleName), err)
+	// only the supported chains have snapshots
+	params := keeper.GetParams(ctx)
+	params.SupportedBlockchains = nil
+	keeper.SetParams(ctx, params)
+	nextSessionHeight := header.SessionBlockHeight + keeper.SessionFrequency(ctx)
+	keeper.SetSessionSnapshots(ctx.WithBlockHeight(nextSessionHeight))
+	_, found = keeper.GetSessionSnapshot(ctx, nextSessionHeight, header.Chain)
+	assert.False(t, found)
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"fmt"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// validate the timeout evidence against the world state
+func (k Keeper) ValidateTimeout(ctx sdk.Ctx, timeout pc.ChallengeProofTimeout, header pc.SessionHeader) sdk.Error {
+	servicerAddr, err := timeout.ServicerAddress()
+	if err != nil {
+		return err
+	}
+	// the execution is recorded under the validator address of a rotated consensus key
+	servicerAddr = k.GetValidatorAddress(ctx, servicerAddr)
+	// replay protection: a claim burns the servicer for all of its timeouts in the session, so it can only be burned for once
+	if k.IsTimeoutExecuted(ctx, header, servicerAddr) {
+		return pc.NewTimeoutReplayError(pc.ModuleName)
+	}
+	// the deadline must fall within the session, with the session frequency in force at the session block
+	sessionContext, er := ctx.PrevCtx(header.SessionBlockHeight)
+	if er != nil {
+		return sdk.ErrInternal(er.Error())
+	}
+	if timeout.Deadline >= header.SessionBlockHeight+k.SessionFrequency(sessionContext) {
+		return pc.NewInvalidTimeoutDeadlineError(pc.ModuleName)
+	}
+	// retrieve the session from the cache or the session snapshot
+	session, err := k.GetSession(ctx, header)
+	if err != nil {
+		return err
+	}
+	// the accused and the attesters must all be session nodes
+	return timeout.ValidateSessionNodes(session.SessionNodes, func(addr sdk.Address) sdk.Address { return k.GetValidatorAddress(ctx, addr) })
+}
+
+// record the executed timeout evidence in the world state, marking every timeout of the servicer in the session
+func (k Keeper) SetTimeout(ctx sdk.Ctx, header pc.SessionHeader, servicerAddr sdk.Address, timeout pc.ChallengeProofTimeout) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(pc.KeyForTimeout(header, servicerAddr), timeout.Hash())
+}
+
+// has the timeout evidence against the servicer for this session already been executed?
+func (k Keeper) IsTimeoutExecuted(ctx sdk.Ctx, header pc.SessionHeader, servicerAddr sdk.Address) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(pc.KeyForTimeout(header, servicerAddr))
+}
+
+func (k Keeper) HandleTimeout(ctx sdk.Ctx, timeout pc.ChallengeProofTimeout) (*pc.ChallengeResponse, sdk.Error) {
+	// get self node (your validator) from the current state
+	selfNode, err := k.GetSelfNode(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sessionBlkHeight := k.GetLatestSessionBlockHeight(ctx)
+	// generate header
+	header := pc.SessionHeader{
+		ApplicationPubKey:  timeout.Request.Token.ApplicationPublicKey,
+		Chain:              timeout.Request.Blockchain,
+		SessionBlockHeight: sessionBlkHeight,
+	}
+	// retrieve the session snapshot
+	snapshot, found := k.GetSessionSnapshot(ctx, sessionBlkHeight, header.Chain)
+	if !found {
+		return nil, pc.NewSessionSnapshotNotFoundError(pc.ModuleName)
+	}
+	// get the application that staked on behalf of the client at the session block
+	app, err := snapshot.App(header.ApplicationPubKey)
+	if err != nil {
+		return nil, err
+	}
+	sessionNodeCount := int(app.SessionNodeCount)
+	// retrieve the session from the cache or the session snapshot
+	session, err := snapshot.Session(header)
+	if err != nil {
+		return nil, err
+	}
+	servicerAddr, err := timeout.ServicerAddress()
+	if err != nil {
+		return nil, err
+	}
+	servicerAddr = k.GetValidatorAddress(ctx, servicerAddr)
+	// no need to store evidence that was already executed
+	if k.IsTimeoutExecuted(ctx, header, servicerAddr) {
+		return nil, pc.NewTimeoutReplayError(pc.ModuleName)
+	}
+	// the deadline must have passed within the session, with the session frequency in force at the session block
+	sessionContext, er := ctx.PrevCtx(sessionBlkHeight)
+	if er != nil {
+		return nil, sdk.ErrInternal(er.Error())
+	}
+	if timeout.Deadline > ctx.BlockHeight() || timeout.Deadline >= sessionBlkHeight+k.SessionFrequency(sessionContext) {
+		return nil, pc.NewInvalidTimeoutDeadlineError(pc.ModuleName)
+	}
+	// validate the timeout
+	err = timeout.ValidateLocal(app.MaxRelays.Int64(), sessionBlkHeight, []string{header.Chain}, sessionNodeCount, session.SessionNodes, selfNode.GetAddress(),
+		func(addr sdk.Address) sdk.Address { return k.GetValidatorAddress(ctx, addr) })
+	if err != nil {
+		return nil, err
+	}
+	ctx.Logger().Info(fmt.Sprintf("timeout evidence stored against %s for app: %s", timeout.Request.ServicerPubKey, header.ApplicationPubKey))
+	// store the timeout in memory
+	timeout.Handle()
+	return nil, nil
+}
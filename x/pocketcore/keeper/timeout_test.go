@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	nodesKeeper "github.com/pokt-network/pocket-core/x/nodes/keeper"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestKeeper_ExecuteTimeoutProof(t *testing.T) {
+	ctx, vals, _, _, keeper, keys := createTestInput(t, false)
+	accused := vals[0]
+	reporter := vals[1]
+	timeout := types.ChallengeProofTimeout{
+		Request: types.RelayProof{
+			Entropy:            1,
+			SessionBlockHeight: 1,
+			ServicerPubKey:     accused.PublicKey.RawString(),
+			Blockchain:         getTestSupportedBlockchain(),
+			Token: types.AAT{
+				Version:              "0.0.1",
+				ApplicationPublicKey: getTestApplication().PublicKey.RawString(),
+				ClientPublicKey:      getRandomPubKey().RawString(),
+			},
+		},
+		Deadline: 1,
+		Attestations: [2]types.TimeoutAttestation{
+			{AttesterPubKey: vals[2].PublicKey.RawString()},
+			{AttesterPubKey: vals[3].PublicKey.RawString()},
+		},
+		ReporterAddress: reporter.Address,
+	}
+	claim := types.MsgClaim{
+		SessionHeader: timeout.SessionHeader(),
+		MerkleRoot:    types.HashSum{},
+		TotalProofs:   5,
+		FromAddress:   reporter.Address,
+		EvidenceType:  types.TimeoutEvidence,
+	}
+	mockCtx := &Ctx{}
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys["pos"]).Return(ctx.KVStore(keys["pos"]))
+	mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+	mockCtx.On("Logger").Return(ctx.Logger())
+	mockCtx.On("PrevCtx", claim.SessionBlockHeight).Return(ctx, nil)
+	assert.Nil(t, keeper.SetClaim(mockCtx, claim))
+	assert.False(t, keeper.IsTimeoutExecuted(ctx, claim.SessionHeader, accused.Address))
+	err := keeper.ExecuteProof(mockCtx, types.MsgProof{Leaf: timeout}, claim)
+	assert.Nil(t, err)
+	// the claim is removed and every timeout of the servicer in the session is marked as executed
+	_, found := keeper.GetClaim(mockCtx, reporter.Address, claim.SessionHeader, types.TimeoutEvidence)
+	assert.False(t, found)
+	assert.True(t, keeper.IsTimeoutExecuted(ctx, claim.SessionHeader, accused.Address))
+	// replaying the same request is rejected
+	er := keeper.ValidateTimeout(mockCtx, timeout, claim.SessionHeader)
+	assert.NotNil(t, er)
+	assert.Equal(t, er.Code(), sdk.CodeType(types.CodeTimeoutReplayError))
+	// another request of the claimed session is rejected as well
+	other := timeout
+	other.Request.Entropy = 2
+	er = keeper.ValidateTimeout(mockCtx, other, claim.SessionHeader)
+	assert.NotNil(t, er)
+	assert.Equal(t, er.Code(), sdk.CodeType(types.CodeTimeoutReplayError))
+	// the servicer of another session is not affected
+	nextHeader := types.SessionHeader{ApplicationPubKey: claim.ApplicationPubKey, Chain: claim.Chain, SessionBlockHeight: 1 + keeper.SessionFrequency(ctx)}
+	assert.False(t, keeper.IsTimeoutExecuted(ctx, nextHeader, accused.Address))
+	// but its deadline must fall within the session
+	mockCtx.On("PrevCtx", nextHeader.SessionBlockHeight).Return(ctx, nil)
+	late := timeout
+	late.Deadline = nextHeader.SessionBlockHeight + keeper.SessionFrequency(ctx)
+	er = keeper.ValidateTimeout(mockCtx, late, nextHeader)
+	assert.NotNil(t, er)
+	assert.Equal(t, er.Code(), sdk.CodeType(types.CodeInvalidTimeoutDeadlineError))
+}
+
+func TestKeeper_ValidateTimeoutSessionFrequency(t *testing.T) {
+	ctx, vals, _, _, keeper, keys := createTestInput(t, false)
+	header := types.SessionHeader{
+		ApplicationPubKey:  getTestApplication().PublicKey.RawString(),
+		Chain:              getTestSupportedBlockchain(),
+		SessionBlockHeight: 1,
+	}
+	timeout := types.ChallengeProofTimeout{
+		Request:  types.RelayProof{ServicerPubKey: vals[0].PublicKey.RawString()},
+		Deadline: header.SessionBlockHeight + keeper.SessionFrequency(ctx) - 1,
+	}
+	// the session frequency is halved after the session block
+	current, _ := ctx.CacheContext()
+	nk := keeper.posKeeper.(nodesKeeper.Keeper)
+	params := nk.GetParams(current)
+	params.SessionBlockFrequency /= 2
+	nk.SetParams(current, params)
+	mockCtx := &Ctx{}
+	mockCtx.On("KVStore", keeper.storeKey).Return(current.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys["pos"]).Return(current.KVStore(keys["pos"]))
+	mockCtx.On("PrevCtx", header.SessionBlockHeight).Return(ctx, nil)
+	// the deadline is within the session with the frequency in force at the session block
+	er := keeper.ValidateTimeout(mockCtx, timeout, header)
+	if er != nil {
+		assert.NotEqual(t, er.Code(), sdk.CodeType(types.CodeInvalidTimeoutDeadlineError))
+	}
+	// but not with the current frequency
+	mockCtx = &Ctx{}
+	mockCtx.On("KVStore", keeper.storeKey).Return(current.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys["pos"]).Return(current.KVStore(keys["pos"]))
+	mockCtx.On("PrevCtx", header.SessionBlockHeight).Return(current, nil)
+	er = keeper.ValidateTimeout(mockCtx, timeout, header)
+	assert.NotNil(t, er)
+	assert.Equal(t, er.Code(), sdk.CodeType(types.CodeInvalidTimeoutDeadlineError))
+}
@@ -86,6 +86,14 @@ func (am AppModule) BeginBlock(ctx sdk.Ctx, req abci.RequestBeginBlock) {
 			am.keeper.SendClaimTx(ctx, am.keeper.TmNode, am.keeper.Keybase, ClaimTx)
 			// auto claim the proofs
 			am.keeper.SendProofTx(ctx, am.keeper.TmNode, am.keeper.Keybase, ProofTx)
+			// auto unjail the self node if enabled
+			if am.keeper.AutoUnjail {
+				am.keeper.SendUnjailTx(ctx, am.keeper.TmNode, am.keeper.Keybase, UnjailTx)
+			}
+			// auto compound the rewards of the self node if enabled
+			if am.keeper.AutoCompound {
+				am.keeper.SendCompoundTx(ctx, am.keeper.TmNode, am.keeper.Keybase, CompoundTx)
+			}
 			// clear session cache and db
 			types.ClearSessionCache()
 		}()
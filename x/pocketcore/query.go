@@ -129,6 +129,30 @@ func QueryChallenge(cdc *codec.Codec, tmNode client.Client, challengeProof types
 	return &response, nil
 }
 
+func QueryTimeout(cdc *codec.Codec, tmNode client.Client, timeoutProof types.ChallengeProofTimeout) (*types.ChallengeResponse, error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(0)
+	params := types.QueryTimeoutParams{
+		Timeout: timeoutProof,
+	}
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryTimeout), bz)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, errors.New("nil response error")
+	}
+	var response types.ChallengeResponse
+	err = cdc.UnmarshalJSON(res, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
 func QueryDispatch(cdc *codec.Codec, tmNode client.Client, header types.SessionHeader) (*types.DispatchResponse, error) {
 	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(0)
 	params := types.QueryDispatchParams{
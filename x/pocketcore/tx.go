@@ -1,6 +1,8 @@
 package pocketcore
 
 import (
+	nodesexported "github.com/pokt-network/pocket-core/x/nodes/exported"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	"github.com/pokt-network/pocket-core/x/pocketcore/keeper"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/pokt-network/posmint/crypto/keys"
@@ -43,6 +45,46 @@ func ProofTx(cliCtx util.CLIContext, txBuilder auth.TxBuilder, branches [2]types
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
 }
 
+// transaction to report an application misbehaviour proven by the relays of the self node
+func AppEvidenceTx(cliCtx util.CLIContext, txBuilder auth.TxBuilder, evidence types.AppEvidence, reporter sdk.Address) (*sdk.TxResponse, error) {
+	msg := types.MsgAppEvidence{
+		Evidence:        evidence,
+		ReporterAddress: reporter,
+	}
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
+// transaction to unjail the self node
+// NOTE the message is signed by the coinbase so the node must be owned by it
+func UnjailTx(cliCtx util.CLIContext, txBuilder auth.TxBuilder, address sdk.Address) (*sdk.TxResponse, error) {
+	msg := nodesTypes.MsgUnjail{ValidatorAddr: address}
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
+// transaction to top up the stake of the self node, the chains and service url are unchanged
+// NOTE the message is signed by the coinbase so the node must be owned by it
+func CompoundTx(cliCtx util.CLIContext, txBuilder auth.TxBuilder, node nodesexported.ValidatorI, amount sdk.Int) (*sdk.TxResponse, error) {
+	msg := nodesTypes.MsgStake{
+		PublicKey:  node.GetPublicKey(),
+		Chains:     node.GetChains(),
+		ServiceURL: node.GetServiceURL(),
+		Value:      amount,
+	}
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
 func GenerateChain(ticker, netid, version, client, inter string) (string, error) {
 	return keeper.GenerateChain(ticker, netid, version, client, inter)
 }
@@ -0,0 +1,91 @@
+package types
+
+import (
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// application infractions
+const (
+	InfractionRelaysOverCap = "relays_over_cap"
+	// a servicer stops serving at its share of the max relays, so retries and concurrent relays of honest clients
+	// only spill a few relays over it: the evidence must prove this many times the share
+	RelaysOverCapMargin = 2
+)
+
+// AppEvidence proves with client signed relays that an application broke the relay rules of a session
+type AppEvidence interface {
+	ValidateBasic() sdk.Error
+	Validate(appSupportedBlockchains []string, sessionNodeCount int, chainMaxRelays int64) sdk.Error
+	SessionHeader() SessionHeader
+	ServicerPubKey() string
+	Infraction() string
+}
+
+var _ AppEvidence = RelaysOverCapEvidence{}
+
+// RelaysOverCapEvidence proves the application authorized well over its share of the session max relays for a servicer
+type RelaysOverCapEvidence struct {
+	Relays []RelayProof `json:"relays"` // distinct relays of one session, all addressed to the servicer
+}
+
+func (e RelaysOverCapEvidence) ValidateBasic() sdk.Error {
+	if len(e.Relays) == 0 {
+		return NewEmptyProofsError(ModuleName)
+	}
+	if err := validateSameSession(e.Relays); err != nil {
+		return err
+	}
+	// every relay must be counted once
+	hashes := make(map[string]struct{}, len(e.Relays))
+	for _, r := range e.Relays {
+		if _, found := hashes[r.HashString()]; found {
+			return NewDuplicateProofError(ModuleName)
+		}
+		hashes[r.HashString()] = struct{}{}
+	}
+	return nil
+}
+
+func (e RelaysOverCapEvidence) Validate(appSupportedBlockchains []string, sessionNodeCount int, chainMaxRelays int64) sdk.Error {
+	// the relays must exceed the margin over the servicer share of the max relays of the chain
+	if int64(len(e.Relays)) <= RelaysOverCapMargin*SessionNodeMaxRelays(chainMaxRelays, sessionNodeCount) {
+		return NewRelaysNotOverCapError(ModuleName)
+	}
+	return validateRelays(e.Relays, appSupportedBlockchains, sessionNodeCount)
+}
+
+func (e RelaysOverCapEvidence) SessionHeader() SessionHeader {
+	return e.Relays[0].SessionHeader()
+}
+
+func (e RelaysOverCapEvidence) ServicerPubKey() string {
+	return e.Relays[0].ServicerPubKey
+}
+
+func (e RelaysOverCapEvidence) Infraction() string {
+	return InfractionRelaysOverCap
+}
+
+// validate the format of the relays and that they are all from the same session and servicer
+func validateSameSession(relays []RelayProof) sdk.Error {
+	header, servicer := relays[0].SessionHeader(), relays[0].ServicerPubKey
+	for _, r := range relays {
+		if err := r.ValidateBasic(); err != nil {
+			return err
+		}
+		if r.SessionHeader() != header || r.ServicerPubKey != servicer {
+			return NewMismatchedRelaysError(ModuleName)
+		}
+	}
+	return nil
+}
+
+// validate the relays against the application of the session
+func validateRelays(relays []RelayProof, appSupportedBlockchains []string, sessionNodeCount int) sdk.Error {
+	for _, r := range relays {
+		if err := r.Validate(appSupportedBlockchains, sessionNodeCount, r.SessionBlockHeight); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"encoding/hex"
+	"github.com/pokt-network/posmint/crypto"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// sign a relay of the application client
+func newSignedRelay(t *testing.T, appPrivateKey, clientPrivateKey crypto.PrivateKey, servicerPubKey, chain string, entropy int64, request string) RelayProof {
+	relay := RelayProof{
+		Entropy:            entropy,
+		SessionBlockHeight: 1,
+		ServicerPubKey:     servicerPubKey,
+		RequestHash:        Payload{Data: request}.HashString(),
+		Blockchain:         chain,
+		Token: AAT{
+			Version:              "0.0.1",
+			ApplicationPublicKey: appPrivateKey.PublicKey().RawString(),
+			ClientPublicKey:      clientPrivateKey.PublicKey().RawString(),
+		},
+	}
+	appSignature, err := appPrivateKey.Sign(relay.Token.Hash())
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	relay.Token.ApplicationSignature = hex.EncodeToString(appSignature)
+	clientSignature, err := clientPrivateKey.Sign(relay.Hash())
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	relay.Signature = hex.EncodeToString(clientSignature)
+	return relay
+}
+
+func TestRelaysOverCapEvidence(t *testing.T) {
+	appPrivateKey, clientPrivateKey := GetRandomPrivateKey(), GetRandomPrivateKey()
+	servicerPubKey := getRandomPubKey().RawString()
+	chain := getTestSupportedBlockchain()
+	relays := make([]RelayProof, 5)
+	for i := range relays {
+		relays[i] = newSignedRelay(t, appPrivateKey, clientPrivateKey, servicerPubKey, chain, int64(i), "request")
+	}
+	evidence := RelaysOverCapEvidence{Relays: relays}
+	assert.Nil(t, evidence.ValidateBasic())
+	assert.Equal(t, InfractionRelaysOverCap, evidence.Infraction())
+	assert.Equal(t, servicerPubKey, evidence.ServicerPubKey())
+	// 5 relays are over the margin of the cap of 10 relays split between 5 nodes
+	assert.Nil(t, evidence.Validate([]string{chain}, 5, 10))
+	// 3 relays are over the cap but within the margin
+	err := RelaysOverCapEvidence{Relays: relays[:3]}.Validate([]string{chain}, 5, 10)
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(CodeRelaysNotOverCapError), err.Code())
+	// and 5 relays are within the margin of the cap of 15 relays
+	err = evidence.Validate([]string{chain}, 5, 15)
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(CodeRelaysNotOverCapError), err.Code())
+	// unsupported chain
+	assert.NotNil(t, evidence.Validate([]string{getRandomPubKey().RawString()}, 5, 10))
+	// duplicate relays
+	duplicate := RelaysOverCapEvidence{Relays: []RelayProof{relays[0], relays[1], relays[0]}}
+	err = duplicate.ValidateBasic()
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(CodeDuplicateProofError), err.Code())
+	// relays of another servicer
+	other := newSignedRelay(t, appPrivateKey, clientPrivateKey, getRandomPubKey().RawString(), chain, 5, "request")
+	mismatched := RelaysOverCapEvidence{Relays: []RelayProof{relays[0], relays[1], other}}
+	err = mismatched.ValidateBasic()
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(CodeMismatchedRelaysError), err.Code())
+	// no relays
+	assert.NotNil(t, RelaysOverCapEvidence{}.ValidateBasic())
+}
+
+func TestMsgAppEvidence_ValidateBasic(t *testing.T) {
+	appPrivateKey, clientPrivateKey, servicerPrivateKey := GetRandomPrivateKey(), GetRandomPrivateKey(), GetRandomPrivateKey()
+	servicerPubKey := servicerPrivateKey.PublicKey().RawString()
+	chain := getTestSupportedBlockchain()
+	relay := newSignedRelay(t, appPrivateKey, clientPrivateKey, servicerPubKey, chain, 1, "request")
+	next := newSignedRelay(t, appPrivateKey, clientPrivateKey, servicerPubKey, chain, 2, "request")
+	msg := MsgAppEvidence{
+		Evidence:        RelaysOverCapEvidence{Relays: []RelayProof{relay, next}},
+		ReporterAddress: sdk.Address(servicerPrivateKey.PublicKey().Address()),
+	}
+	assert.Nil(t, msg.ValidateBasic())
+	assert.Equal(t, MsgAppEvidenceName, msg.Type())
+	assert.Equal(t, []sdk.Address{msg.ReporterAddress}, msg.GetSigners())
+	// only the servicer may report its relays
+	notServicer := msg
+	notServicer.ReporterAddress = getRandomValidatorAddress()
+	assert.NotNil(t, notServicer.ValidateBasic())
+	// the evidence is required
+	noEvidence := msg
+	noEvidence.Evidence = nil
+	assert.NotNil(t, noEvidence.ValidateBasic())
+}
@@ -23,6 +23,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*Proof)(nil), nil)
 	cdc.RegisterConcrete(RelayProof{}, "pocketcore/relay_proof", nil)
 	cdc.RegisterConcrete(ChallengeProofInvalidData{}, "pocketcore/challenge_proof_invalid_data", nil)
+	cdc.RegisterConcrete(ChallengeProofTimeout{}, "pocketcore/challenge_proof_timeout", nil)
+	cdc.RegisterConcrete(MsgAppEvidence{}, "pocketcore/app_evidence", nil)
+	cdc.RegisterInterface((*AppEvidence)(nil), nil)
+	cdc.RegisterConcrete(RelaysOverCapEvidence{}, "pocketcore/relays_over_cap_evidence", nil)
 	cdc.RegisterInterface((*exported.ValidatorI)(nil), nil)
 	cdc.RegisterConcrete(nodesTypes.Validator{}, "pos/Validator", nil) // todo does this really need to depend on nodes/types
 }
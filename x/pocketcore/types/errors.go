@@ -86,6 +86,15 @@ const ( // todo re-number
 	CodeNodeNotInSessionError            = 1193
 	CodeNoEvidenceTypeErr                = 1194
 	CodeInvalidPkFileErr                 = 1195
+	CodeTimeoutReplayError               = 1196
+	CodeSessionSnapshotNotFoundError     = 1197
+	CodeSessionHeightInFutureError       = 1198
+	CodeAppEvidenceReplayError           = 1199
+	CodeMismatchedRelaysError            = 1200
+	CodeRelaysNotOverCapError            = 1201
+	CodeAppNotStakedError                = 1202
+	CodeInvalidTimeoutDeadlineError      = 1203
+	CodeMultipleTimeoutServicersError    = 1204
 )
 
 var (
@@ -173,6 +182,15 @@ var (
 	NoMajorityResponseError          = errors.New("no majority can be established between all of the responses")
 	NoEvidenceTypeErr                = errors.New("the evidence type is not supplied in the claim message")
 	InvalidPkFileErr                 = errors.New("the PK File is not found")
+	TimeoutReplayError               = errors.New("the timeout evidence for this request has already been executed")
+	SessionSnapshotNotFoundError     = errors.New("no session snapshot was persisted for the chain at the session block height")
+	SessionHeightInFutureError       = errors.New("the session block height is beyond the current session")
+	AppEvidenceReplayError           = errors.New("the application has already been penalized for this session")
+	MismatchedRelaysError            = errors.New("the relays of the application evidence are not from the same servicer and session")
+	RelaysNotOverCapError            = errors.New("the relays do not exceed the margin over the relay cap of the servicer for the session")
+	AppNotStakedError                = errors.New("the application is not staked")
+	InvalidTimeoutDeadlineError      = errors.New("the deadline of the timeout evidence is not within the session")
+	MultipleTimeoutServicersError    = errors.New("the timeout evidence of a session may only accuse a single servicer")
 )
 
 func NewUnsupportedBlockchainError(codespace sdk.CodespaceType) sdk.Error {
@@ -439,3 +457,39 @@ func NewInvalidTokenError(codespace sdk.CodespaceType, err error) sdk.Error {
 func NewInvalidPKError(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidPkFileErr, InvalidPkFileErr.Error())
 }
+
+func NewTimeoutReplayError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeTimeoutReplayError, TimeoutReplayError.Error())
+}
+
+func NewSessionSnapshotNotFoundError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSessionSnapshotNotFoundError, SessionSnapshotNotFoundError.Error())
+}
+
+func NewSessionHeightInFutureError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSessionHeightInFutureError, SessionHeightInFutureError.Error())
+}
+
+func NewAppEvidenceReplayError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeAppEvidenceReplayError, AppEvidenceReplayError.Error())
+}
+
+func NewMismatchedRelaysError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeMismatchedRelaysError, MismatchedRelaysError.Error())
+}
+
+func NewRelaysNotOverCapError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeRelaysNotOverCapError, RelaysNotOverCapError.Error())
+}
+
+func NewAppNotStakedError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeAppNotStakedError, AppNotStakedError.Error())
+}
+
+func NewInvalidTimeoutDeadlineError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidTimeoutDeadlineError, InvalidTimeoutDeadlineError.Error())
+}
+
+func NewMultipleTimeoutServicersError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeMultipleTimeoutServicersError, MultipleTimeoutServicersError.Error())
+}
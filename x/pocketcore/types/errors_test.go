@@ -45,3 +45,27 @@ func TestInvalidHashLengthError(t *testing.T) {
 func TestInvalidAppPubKeyError(t *testing.T) {
 	assert.Equal(t, NewInvalidAppPubKeyError(ModuleName), sdk.NewError(ModuleName, CodeInvalidAppPubKeyError, InvalidAppPubKeyError.Error()))
 }
+
+func TestNewTimeoutReplayError(t *testing.T) {
+	assert.Equal(t, NewTimeoutReplayError(ModuleName), sdk.NewError(ModuleName, CodeTimeoutReplayError, TimeoutReplayError.Error()))
+}
+
+func TestNewSessionSnapshotNotFoundError(t *testing.T) {
+	assert.Equal(t, NewSessionSnapshotNotFoundError(ModuleName), sdk.NewError(ModuleName, CodeSessionSnapshotNotFoundError, SessionSnapshotNotFoundError.Error()))
+}
+
+func TestNewSessionHeightInFutureError(t *testing.T) {
+	assert.Equal(t, NewSessionHeightInFutureError(ModuleName), sdk.NewError(ModuleName, CodeSessionHeightInFutureError, SessionHeightInFutureError.Error()))
+}
+
+func TestNewAppEvidenceReplayError(t *testing.T) {
+	assert.Equal(t, NewAppEvidenceReplayError(ModuleName), sdk.NewError(ModuleName, CodeAppEvidenceReplayError, AppEvidenceReplayError.Error()))
+}
+
+func TestNewInvalidTimeoutDeadlineError(t *testing.T) {
+	assert.Equal(t, NewInvalidTimeoutDeadlineError(ModuleName), sdk.NewError(ModuleName, CodeInvalidTimeoutDeadlineError, InvalidTimeoutDeadlineError.Error()))
+}
+
+func TestNewMultipleTimeoutServicersError(t *testing.T) {
+	assert.Equal(t, NewMultipleTimeoutServicersError(ModuleName), sdk.NewError(ModuleName, CodeMultipleTimeoutServicersError, MultipleTimeoutServicersError.Error()))
+}
@@ -2,7 +2,10 @@ package types
 
 // pc module event types
 const (
-	EventTypeClaim        = MsgClaimName
-	EventTypeProof        = MsgProofName
-	AttributeKeyValidator = "validator"
+	EventTypeClaim          = MsgClaimName
+	EventTypeProof          = MsgProofName
+	EventTypeAppEvidence    = MsgAppEvidenceName
+	AttributeKeyValidator   = "validator"
+	AttributeKeyApplication = "application"
+	AttributeKeyInfraction  = "infraction"
 )
@@ -35,6 +35,7 @@ type EvidenceType int
 const (
 	RelayEvidence EvidenceType = iota + 1
 	ChallengeEvidence
+	TimeoutEvidence
 )
 
 func (et EvidenceType) Byte() byte {
@@ -43,6 +44,8 @@ func (et EvidenceType) Byte() byte {
 		return 0
 	case ChallengeEvidence:
 		return 1
+	case TimeoutEvidence:
+		return 2
 	default:
 		panic("unrecognized evidence type")
 	}
@@ -15,8 +15,13 @@ type PosKeeper interface {
 	JailValidator(ctx sdk.Ctx, addr sdk.Address)
 	AllValidators(ctx sdk.Ctx) (validators []nodesexported.ValidatorI)
 	GetStakedValidators(ctx sdk.Ctx) (validators []nodesexported.ValidatorI)
+	GetNodesForChain(ctx sdk.Ctx, chain string) (validators []nodesexported.ValidatorI)
 	SessionBlockFrequency(ctx sdk.Ctx) (res int64)
 	StakeDenom(ctx sdk.Ctx) (res string)
+	GetBalance(ctx sdk.Ctx, addr sdk.Address) sdk.Int
+	IsWaitingValidator(ctx sdk.Ctx, valAddr sdk.Address) bool
+	ValidateUnjail(ctx sdk.Ctx, address sdk.Address) (addr sdk.Address, err sdk.Error)
+	ValidatorAddressFromConsensusAddress(ctx sdk.Ctx, consAddr sdk.Address) sdk.Address
 }
 
 type AppsKeeper interface {
@@ -25,4 +30,6 @@ type AppsKeeper interface {
 	AllApplications(ctx sdk.Ctx) (applications []appexported.ApplicationI)
 	TotalTokens(ctx sdk.Ctx) sdk.Int
 	JailApplication(ctx sdk.Ctx, addr sdk.Address)
+	BurnForMisbehaviour(ctx sdk.Ctx, addr sdk.Address)
+	RecordRelayUsage(ctx sdk.Ctx, addr sdk.Address, chain string, sessionHeight int64, maxRelays sdk.Int, claimedRelays, provenRelays int64)
 }
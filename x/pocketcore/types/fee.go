@@ -1,13 +1,15 @@
 package types
 
 const (
-	ClaimFee = 100000
-	ProofFee = 100000
+	ClaimFee       = 100000
+	ProofFee       = 100000
+	AppEvidenceFee = 100000
 )
 
 var (
 	PocketFeeMap = map[string]int64{
-		MsgClaimName: ClaimFee,
-		MsgProofName: ProofFee,
+		MsgClaimName:       ClaimFee,
+		MsgProofName:       ProofFee,
+		MsgAppEvidenceName: AppEvidenceFee,
 	}
 )
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/binary"
 	"encoding/hex"
 	sdk "github.com/pokt-network/posmint/types"
 )
@@ -12,8 +13,11 @@ const (
 )
 
 var (
-	ReceiptKey = []byte{0x01} // key for the verified proofs
-	ClaimKey   = []byte{0x02} // key for non-verified proofs
+	ReceiptKey         = []byte{0x01} // key for the verified proofs
+	ClaimKey           = []byte{0x02} // key for non-verified proofs
+	TimeoutKey         = []byte{0x03} // key for executed timeout evidence (replay protection)
+	SessionSnapshotKey = []byte{0x04} // key for the session snapshots persisted at each session block
+	AppEvidenceKey     = []byte{0x05} // key for executed application evidence (replay protection)
 )
 
 func KeyForReceipt(ctx sdk.Ctx, addr sdk.Address, header SessionHeader, evidenceType EvidenceType) ([]byte, error) {
@@ -70,6 +74,24 @@ func KeyForClaims(addr sdk.Address) ([]byte, error) {
 	return append(ClaimKey, addr.Bytes()...), nil
 }
 
+func KeyForTimeout(header SessionHeader, servicerAddr sdk.Address) []byte {
+	return append(append(append([]byte{}, TimeoutKey...), header.Hash()...), servicerAddr.Bytes()...)
+}
+
+func KeyForAppEvidence(header SessionHeader) []byte {
+	return append(append([]byte{}, AppEvidenceKey...), header.Hash()...)
+}
+
+func KeyForSessionSnapshots(sessionBlockHeight int64) []byte {
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, uint64(sessionBlockHeight))
+	return append(append([]byte{}, SessionSnapshotKey...), heightBz...)
+}
+
+func KeyForSessionSnapshot(sessionBlockHeight int64, chain string) []byte {
+	return append(KeyForSessionSnapshots(sessionBlockHeight), []byte(chain)...)
+}
+
 func KeyForEvidence(header SessionHeader, evidenceType EvidenceType) []byte {
 	return append(header.Hash(), evidenceType.Byte())
 }
@@ -81,6 +103,8 @@ func KeyForEvidenceByProof(header SessionHeader, p Proof) []byte {
 		evidenceType = RelayEvidence
 	case ChallengeProofInvalidData:
 		evidenceType = ChallengeEvidence
+	case ChallengeProofTimeout:
+		evidenceType = TimeoutEvidence
 	default:
 		panic("unrecognized evidence type (key for evidence by proof)")
 	}
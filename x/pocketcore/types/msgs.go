@@ -2,18 +2,22 @@ package types
 
 import (
 	"encoding/hex"
+	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
 	"reflect"
 )
 
 // RouterKey is the module name router key
 const (
-	RouterKey    = ModuleName
-	MsgClaimName = "claim"
-	MsgProofName = "proof"
+	RouterKey          = ModuleName
+	MsgClaimName       = "claim"
+	MsgProofName       = "proof"
+	MsgAppEvidenceName = "app_evidence"
 )
 
-// MsgClaim claims that you completed `NumOfProofs` and provides the merkle root for data integrity
+// MsgClaim claims that you completed `NumOfProofs` and provides the merkle root for data integrity,
+// a timeout claim burns the accused servicer once for all of its `TotalProofs` timeouts of the session: the first proven
+// timeout claim marks the servicer as executed for the session, so later timeout claims against it are rejected
 type MsgClaim struct {
 	SessionHeader `json:"header"` // header information for identification
 	MerkleRoot    HashSum         `json:"merkle_root"`   // merkle root for data integrity
@@ -115,3 +119,44 @@ func (msg MsgProof) GetSignBytes() []byte {
 func (msg MsgProof) GetSigners() []sdk.Address {
 	return msg.Leaf.GetSigners()
 }
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+// MsgAppEvidence reports an application misbehaviour proven by the client signed relays of a servicer
+type MsgAppEvidence struct {
+	Evidence        AppEvidence `json:"evidence"` // the proof of the misbehaviour
+	ReporterAddress sdk.Address `json:"address"`  // the servicer of the relays
+}
+
+func (msg MsgAppEvidence) Route() string { return RouterKey }
+func (msg MsgAppEvidence) Type() string  { return MsgAppEvidenceName }
+func (msg MsgAppEvidence) ValidateBasic() sdk.Error {
+	if msg.ReporterAddress == nil {
+		return NewEmptyAddressError(ModuleName)
+	}
+	if msg.Evidence == nil {
+		return NewEmptyProofsError(ModuleName)
+	}
+	if err := msg.Evidence.ValidateBasic(); err != nil {
+		return err
+	}
+	// only the servicer of the relays may report them
+	pk, err := crypto.NewPublicKey(msg.Evidence.ServicerPubKey())
+	if err != nil {
+		return NewPubKeyError(ModuleName, err)
+	}
+	if !sdk.Address(pk.Address()).Equals(msg.ReporterAddress) {
+		return NewInvalidNodePubKeyError(ModuleName)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAppEvidence) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAppEvidence) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.ReporterAddress}
+}
@@ -14,10 +14,13 @@ const (
 	DefaultSessionNodeCount      = int64(5)
 	DefaultClaimSubmissionWindow = int64(3)
 	DefaultClaimExpiration       = int64(100) // sessions
+	DefaultStakeWeightedSessions = false
+	MaxSessionNodeCount          = int64(25)
 )
 
 var (
-	DefaultSupportedBlockchains []string // todo add defaults
+	DefaultSupportedBlockchains  []string              // todo add defaults
+	DefaultSessionNodeCountTiers SessionNodeCountTiers // every application uses the session node count
 )
 
 // nolint - Keys for parameter access
@@ -26,16 +29,20 @@ var (
 	KeyClaimSubmissionWindow = []byte("ClaimSubmissionWindow")
 	KeySupportedBlockchains  = []byte("SupportedBlockchains")
 	KeyClaimExpiration       = []byte("ClaimExpiration")
+	KeyStakeWeightedSessions = []byte("StakeWeightedSessions")
+	KeySessionNodeCountTiers = []byte("SessionNodeCountTiers")
 )
 
 var _ types.ParamSet = (*Params)(nil)
 
 // Params defines the high level settings for pos module
 type Params struct {
-	SessionNodeCount      int64    `json:"session_node_count"`
-	ClaimSubmissionWindow int64    `json:"proof_waiting_period"`
-	SupportedBlockchains  []string `json:"supported_blockchains"`
-	ClaimExpiration       int64    `json:"claim_expiration"` // per session
+	SessionNodeCount      int64                 `json:"session_node_count"`
+	ClaimSubmissionWindow int64                 `json:"proof_waiting_period"`
+	SupportedBlockchains  []string              `json:"supported_blockchains"`
+	ClaimExpiration       int64                 `json:"claim_expiration"`         // per session
+	StakeWeightedSessions bool                  `json:"stake_weighted_sessions"`  // select session nodes proportional to their stake
+	SessionNodeCountTiers SessionNodeCountTiers `json:"session_node_count_tiers"` // session node count by application stake
 }
 
 // Implements params.ParamSet
@@ -45,6 +52,8 @@ func (p *Params) ParamSetPairs() types.ParamSetPairs {
 		{Key: KeyClaimSubmissionWindow, Value: &p.ClaimSubmissionWindow},
 		{Key: KeySupportedBlockchains, Value: &p.SupportedBlockchains},
 		{Key: KeyClaimExpiration, Value: &p.ClaimExpiration},
+		{Key: KeyStakeWeightedSessions, Value: &p.StakeWeightedSessions},
+		{Key: KeySessionNodeCountTiers, Value: &p.SessionNodeCountTiers},
 	}
 }
 
@@ -55,12 +64,14 @@ func DefaultParams() Params {
 		ClaimSubmissionWindow: DefaultClaimSubmissionWindow,
 		SupportedBlockchains:  DefaultSupportedBlockchains,
 		ClaimExpiration:       DefaultClaimExpiration,
+		StakeWeightedSessions: DefaultStakeWeightedSessions,
+		SessionNodeCountTiers: DefaultSessionNodeCountTiers,
 	}
 }
 
 // validate a set of params
 func (p Params) Validate() error {
-	if p.SessionNodeCount > 25 || p.SessionNodeCount < 1 {
+	if p.SessionNodeCount > MaxSessionNodeCount || p.SessionNodeCount < 1 {
 		return errors.New("Invalid session node count")
 	}
 	if p.ClaimSubmissionWindow < 2 {
@@ -80,6 +91,9 @@ func (p Params) Validate() error {
 	if p.ClaimExpiration < p.ClaimSubmissionWindow {
 		return errors.New("unverified Proof expiration is far too short, must be greater than Proof waiting period")
 	}
+	if err := p.SessionNodeCountTiers.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -97,9 +111,50 @@ func (p Params) String() string {
   ClaimSubmissionWindow:        %d
   Supported Blockchains      %v
   ClaimExpiration            %d
+  StakeWeightedSessions      %t
+  SessionNodeCountTiers      %v
 `,
 		p.SessionNodeCount,
 		p.ClaimSubmissionWindow,
 		p.SupportedBlockchains,
-		p.ClaimExpiration)
+		p.ClaimExpiration,
+		p.StakeWeightedSessions,
+		p.SessionNodeCountTiers)
+}
+
+// the session node count of the applications staking at least the minimum stake
+type SessionNodeCountTier struct {
+	MinStake         types.Int `json:"min_stake"`
+	SessionNodeCount int64     `json:"session_node_count"`
+}
+
+// tiers of session node counts, ordered by ascending minimum stake
+type SessionNodeCountTiers []SessionNodeCountTier
+
+// validate the tiers are ordered and within the session node count bounds
+func (t SessionNodeCountTiers) Validate() error {
+	for i, tier := range t {
+		if !tier.MinStake.IsPositive() {
+			return errors.New("invalid session node count tier, the minimum stake must be positive")
+		}
+		if tier.SessionNodeCount > MaxSessionNodeCount || tier.SessionNodeCount < 1 {
+			return errors.New("invalid session node count tier, invalid session node count")
+		}
+		if i > 0 && !tier.MinStake.GT(t[i-1].MinStake) {
+			return errors.New("invalid session node count tiers, the minimum stakes must be ascending")
+		}
+	}
+	return nil
+}
+
+// the session node count of an application with the stake, the default count applies below the first tier
+func (t SessionNodeCountTiers) SessionNodeCount(defaultCount int64, stake types.Int) int64 {
+	count := defaultCount
+	for _, tier := range t {
+		if stake.LT(tier.MinStake) {
+			break
+		}
+		count = tier.SessionNodeCount
+	}
+	return count
 }
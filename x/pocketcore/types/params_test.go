@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	sdk "github.com/pokt-network/posmint/types"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -40,6 +41,24 @@ func TestParams_Validate(t *testing.T) {
 	// invalid claim expiration
 	invalidParamsClaims := validParams
 	invalidParamsClaims.ClaimExpiration = -1
+	// valid session node count tiers
+	validParamsTiers := validParams
+	validParamsTiers.SessionNodeCountTiers = SessionNodeCountTiers{
+		{MinStake: sdk.NewInt(1000), SessionNodeCount: 10},
+		{MinStake: sdk.NewInt(100000), SessionNodeCount: 20},
+	}
+	// invalid session node count of a tier
+	invalidParamsTierCount := validParams
+	invalidParamsTierCount.SessionNodeCountTiers = SessionNodeCountTiers{{MinStake: sdk.NewInt(1000), SessionNodeCount: 26}}
+	// invalid minimum stake of a tier
+	invalidParamsTierStake := validParams
+	invalidParamsTierStake.SessionNodeCountTiers = SessionNodeCountTiers{{MinStake: sdk.ZeroInt(), SessionNodeCount: 10}}
+	// tiers out of order
+	invalidParamsTierOrder := validParams
+	invalidParamsTierOrder.SessionNodeCountTiers = SessionNodeCountTiers{
+		{MinStake: sdk.NewInt(100000), SessionNodeCount: 20},
+		{MinStake: sdk.NewInt(1000), SessionNodeCount: 10},
+	}
 	tests := []struct {
 		name     string
 		params   Params
@@ -65,11 +84,31 @@ func TestParams_Validate(t *testing.T) {
 			params:   invalidParamsClaims,
 			hasError: true,
 		},
+		{
+			name:     "Invalid Params, session node count tier count",
+			params:   invalidParamsTierCount,
+			hasError: true,
+		},
+		{
+			name:     "Invalid Params, session node count tier stake",
+			params:   invalidParamsTierStake,
+			hasError: true,
+		},
+		{
+			name:     "Invalid Params, session node count tiers order",
+			params:   invalidParamsTierOrder,
+			hasError: true,
+		},
 		{
 			name:     "Valid Params",
 			params:   validParams,
 			hasError: false,
 		},
+		{
+			name:     "Valid Params, session node count tiers",
+			params:   validParamsTiers,
+			hasError: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
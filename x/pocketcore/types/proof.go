@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
-	"math"
 )
 
 type Proof interface {
@@ -212,7 +211,7 @@ type ChallengeProofInvalidData struct {
 var _ Proof = ChallengeProofInvalidData{}
 
 // validate local is used to validate a challenge request directly from a client
-func (c ChallengeProofInvalidData) ValidateLocal(maxRelays, sessionblockHeight int64, supportedBlockchains []string, sessionNodeCount int, sessionNodes SessionNodes, selfAddr sdk.Address) sdk.Error {
+func (c ChallengeProofInvalidData) ValidateLocal(chainMaxRelays, sessionblockHeight int64, supportedBlockchains []string, sessionNodeCount int, sessionNodes SessionNodes, selfAddr sdk.Address) sdk.Error {
 	// get the header to retrieve the evidence object
 	h := SessionHeader{
 		ApplicationPubKey:  c.MinorityResponse.Proof.Token.ApplicationPublicKey,
@@ -221,7 +220,7 @@ func (c ChallengeProofInvalidData) ValidateLocal(maxRelays, sessionblockHeight i
 	}
 	// check for overflow on # of proofs
 	evidence, _ := GetEvidence(h, ChallengeEvidence)
-	if evidence.NumOfProofs >= int64(math.Ceil(float64(maxRelays)/float64(len(supportedBlockchains)))/(float64(sessionNodeCount))) {
+	if evidence.NumOfProofs >= SessionNodeMaxRelays(chainMaxRelays, sessionNodeCount) {
 		return NewOverServiceError(ModuleName)
 	}
 	// check if verifyPubKey in session (must be in session to do challenges)
@@ -425,3 +424,178 @@ func (c ChallengeProofInvalidData) Handle() {
 func (c ChallengeProofInvalidData) EvidenceType() EvidenceType {
 	return ChallengeEvidence
 }
+
+// TimeoutAttestation is a session node's signed statement that the accused servicer did not respond to the request
+type TimeoutAttestation struct {
+	AttesterPubKey string `json:"attester_pub_key"`
+	Signature      string `json:"signature"`
+}
+
+// ChallengeProofTimeout is availability evidence against a servicer that did not respond to a client request
+type ChallengeProofTimeout struct {
+	Request         RelayProof            `json:"request"`      // the client signed request addressed to the unresponsive servicer
+	Deadline        int64                 `json:"deadline"`     // the block height by which the servicer had to respond, signed by the attesters
+	Attestations    [2]TimeoutAttestation `json:"attestations"` // attestations from two other nodes of the session
+	ReporterAddress sdk.Address           `json:"address"`
+}
+
+var _ Proof = ChallengeProofTimeout{}
+
+// validate local is used to validate a timeout report directly from a client, validatorAddress resolves the address of a
+// rotated consensus key to its validator
+func (c ChallengeProofTimeout) ValidateLocal(chainMaxRelays, sessionBlockHeight int64, supportedBlockchains []string, sessionNodeCount int, sessionNodes SessionNodes, selfAddr sdk.Address,
+	validatorAddress func(sdk.Address) sdk.Address) sdk.Error {
+	// check for overflow on # of proofs
+	evidence, _ := GetEvidence(c.SessionHeader(), TimeoutEvidence)
+	if evidence.NumOfProofs >= SessionNodeMaxRelays(chainMaxRelays, sessionNodeCount) {
+		return NewOverServiceError(ModuleName)
+	}
+	// a claim burns a single servicer for all of its timeouts, so the evidence of a session may only accuse one servicer
+	if len(evidence.Proofs) != 0 {
+		if first, ok := evidence.Proofs[0].(ChallengeProofTimeout); ok && first.Request.ServicerPubKey != c.Request.ServicerPubKey {
+			return NewMultipleTimeoutServicersError(ModuleName)
+		}
+	}
+	// check if verifyPubKey in session (must be in session to report timeouts)
+	if !sessionNodes.ContainsAddress(selfAddr) {
+		return NewNodeNotInSessionError(ModuleName)
+	}
+	// the accused servicer and the attesters must be in the session
+	if err := c.ValidateSessionNodes(sessionNodes, validatorAddress); err != nil {
+		return err
+	}
+	// the same timeout may only be reported once
+	if !IsUniqueProof(c.SessionHeader(), c) {
+		return NewDuplicateProofError(ModuleName)
+	}
+	return c.Validate(supportedBlockchains, sessionNodeCount, sessionBlockHeight)
+}
+
+// validate that the accused servicer and all of the attesters are part of the session, their keys may have been rotated
+// since the session block so validatorAddress resolves the address of each key to its validator
+func (c ChallengeProofTimeout) ValidateSessionNodes(sessionNodes SessionNodes, validatorAddress func(sdk.Address) sdk.Address) sdk.Error {
+	pubKeys := []string{c.Request.ServicerPubKey, c.Attestations[0].AttesterPubKey, c.Attestations[1].AttesterPubKey}
+	for _, pk := range pubKeys {
+		pubKey, err := crypto.NewPublicKey(pk)
+		if err != nil {
+			return NewPubKeyError(ModuleName, err)
+		}
+		if !sessionNodes.ContainsAddress(validatorAddress(sdk.Address(pubKey.Address()))) {
+			return NewNodeNotInSessionError(ModuleName)
+		}
+	}
+	return nil
+}
+
+// validate is used to validate a timeout report
+func (c ChallengeProofTimeout) Validate(appSupportedBlockchains []string, sessionNodeCount int, sessionBlockHeight int64) sdk.Error {
+	att, att2 := c.Attestations[0], c.Attestations[1]
+	// check for duplicates (the accused cannot attest against itself)
+	if att.AttesterPubKey == att2.AttesterPubKey ||
+		att.AttesterPubKey == c.Request.ServicerPubKey ||
+		att2.AttesterPubKey == c.Request.ServicerPubKey {
+		return NewDuplicatePublicKeyError(ModuleName)
+	}
+	// the servicer cannot be required to respond before the session starts
+	if c.Deadline < sessionBlockHeight {
+		return NewInvalidTimeoutDeadlineError(ModuleName)
+	}
+	// validate the client signed request
+	if err := c.Request.Validate(appSupportedBlockchains, sessionNodeCount, sessionBlockHeight); err != nil {
+		return err
+	}
+	// check the attestation signatures
+	for _, a := range c.Attestations {
+		if err := SignatureVerification(a.AttesterPubKey, hex.EncodeToString(c.AttestationHash()), a.Signature); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c ChallengeProofTimeout) ValidateBasic() sdk.Error {
+	if c.ReporterAddress == nil {
+		return NewEmptyAddressError(ModuleName)
+	}
+	for _, a := range c.Attestations {
+		if err := PubKeyVerification(a.AttesterPubKey); err != nil {
+			return err
+		}
+		if _, err := hex.DecodeString(a.Signature); err != nil {
+			return NewSigDecodeError(ModuleName)
+		}
+	}
+	return c.Request.ValidateBasic()
+}
+
+func (c ChallengeProofTimeout) SessionHeader() SessionHeader {
+	return c.Request.SessionHeader()
+}
+
+// the address of the accused servicer
+func (c ChallengeProofTimeout) ServicerAddress() (sdk.Address, sdk.Error) {
+	pubKey, err := crypto.NewPublicKey(c.Request.ServicerPubKey)
+	if err != nil {
+		return nil, NewPubKeyError(ModuleName, err)
+	}
+	return sdk.Address(pubKey.Address()), nil
+}
+
+// structure used to generate the bytes an attester signs
+type timeoutAttestation struct {
+	Type     string `json:"type"`
+	Request  string `json:"request"`
+	Deadline int64  `json:"deadline"`
+}
+
+// the hash of the request and the deadline that each attester signs
+func (c ChallengeProofTimeout) AttestationHash() []byte {
+	bz, err := json.Marshal(timeoutAttestation{
+		Type:     "timeout",
+		Request:  c.Request.HashStringWithSignature(),
+		Deadline: c.Deadline,
+	})
+	if err != nil {
+		panic(fmt.Sprintf("an error occured converting the timeout attestation to bytes\n%v", err))
+	}
+	return Hash(bz)
+}
+
+type challengeProofTimeout struct {
+	Request      string                `json:"request"`
+	Deadline     int64                 `json:"deadline"`
+	Attestations [2]TimeoutAttestation `json:"attestations"`
+}
+
+func (c ChallengeProofTimeout) Bytes() []byte {
+	bz, err := json.Marshal(challengeProofTimeout{
+		Request:      c.Request.HashStringWithSignature(),
+		Deadline:     c.Deadline,
+		Attestations: c.Attestations,
+	})
+	if err != nil {
+		panic(fmt.Sprintf("an error occured converting the timeout proof to bytes\n%v", err))
+	}
+	return bz
+}
+
+func (c ChallengeProofTimeout) Hash() []byte {
+	return Hash(c.Bytes())
+}
+
+func (c ChallengeProofTimeout) HashString() string {
+	return hex.EncodeToString(c.Hash())
+}
+
+func (c ChallengeProofTimeout) GetSigners() []sdk.Address {
+	return []sdk.Address{c.ReporterAddress}
+}
+
+func (c ChallengeProofTimeout) Handle() {
+	// add the Proof to the global (in memory) collection of proofs
+	SetProof(c.SessionHeader(), TimeoutEvidence, c)
+}
+
+func (c ChallengeProofTimeout) EvidenceType() EvidenceType {
+	return TimeoutEvidence
+}
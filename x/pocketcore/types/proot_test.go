@@ -743,3 +743,241 @@ func TestChallengeProofInvalidData_SessionHeader(t *testing.T) {
 		SessionBlockHeight: c.MinorityResponse.Proof.SessionBlockHeight,
 	})
 }
+
+func NewValidTimeoutProof(t *testing.T) (timeout ChallengeProofTimeout, servicer crypto.PrivateKey, att1 crypto.PrivateKey, att2 crypto.PrivateKey, repor crypto.PrivateKey) {
+	challenge, servicerPK, att1PK, att2PK, _, _, reporterPK := NewValidChallengeProof(t)
+	timeout = ChallengeProofTimeout{
+		Request:         challenge.MajorityResponses[0].Proof,
+		Deadline:        1,
+		ReporterAddress: challenge.ReporterAddress,
+	}
+	for i, pk := range []crypto.PrivateKey{att1PK, att2PK} {
+		sig, er := pk.Sign(timeout.AttestationHash())
+		if er != nil {
+			t.Fatalf(er.Error())
+		}
+		timeout.Attestations[i] = TimeoutAttestation{
+			AttesterPubKey: pk.PublicKey().RawString(),
+			Signature:      hex.EncodeToString(sig),
+		}
+	}
+	// the first servicer is the accused, the second and third attest
+	return timeout, servicerPK, att1PK, att2PK, reporterPK
+}
+
+func TestChallengeProofTimeout_ValidateBasic(t *testing.T) {
+	validTimeout, _, _, _, _ := NewValidTimeoutProof(t)
+	// invalid empty reporter
+	invalidEmptyRep := validTimeout
+	invalidEmptyRep.ReporterAddress = nil
+	// invalid attestation signature
+	invalidSignature := validTimeout
+	invalidSignature.Attestations[0].Signature = ";"
+	// invalid attester public key
+	invalidAttester := validTimeout
+	invalidAttester.Attestations[1].AttesterPubKey = "abc"
+	tests := []struct {
+		name     string
+		proof    ChallengeProofTimeout
+		hasError bool
+	}{
+		{
+			name:     "valid proof",
+			proof:    validTimeout,
+			hasError: false,
+		},
+		{
+			name:     "invalid proof, empty reporter",
+			proof:    invalidEmptyRep,
+			hasError: true,
+		},
+		{
+			name:     "invalid proof, invalid signature",
+			proof:    invalidSignature,
+			hasError: true,
+		},
+		{
+			name:     "invalid proof, invalid attester",
+			proof:    invalidAttester,
+			hasError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.proof.ValidateBasic() != nil, tt.hasError)
+		})
+	}
+}
+
+func TestChallengeProofTimeout_ValidateLocal(t *testing.T) {
+	InitCacheTest()
+	validTimeout, servicerPK, att1PK, att2PK, reporterPK := NewValidTimeoutProof(t)
+	reporterPubKey := reporterPK.PublicKey()
+	// invalid proof, the accused attests against itself
+	invalidSelfAttest := validTimeout
+	invalidSelfAttest.Attestations[0].AttesterPubKey = servicerPK.PublicKey().RawString()
+	// invalid proof, attestation signed over a different request
+	invalidAttestation := validTimeout
+	invalidAttestation.Attestations[1].Signature = validTimeout.Request.Signature
+	// invalid proof, tampered request
+	invalidRequest := validTimeout
+	invalidRequest.Request.Entropy = 1
+	// invalid proof, tampered deadline
+	invalidDeadline := validTimeout
+	invalidDeadline.Deadline = 2
+	// invalid proof, deadline before the session
+	earlyDeadline := validTimeout
+	earlyDeadline.Deadline = 0
+	for i, pk := range []crypto.PrivateKey{att1PK, att2PK} {
+		sig, er := pk.Sign(earlyDeadline.AttestationHash())
+		if er != nil {
+			t.Fatalf(er.Error())
+		}
+		earlyDeadline.Attestations[i].Signature = hex.EncodeToString(sig)
+	}
+	var sessionNodes SessionNodes
+	for _, pk := range []crypto.PublicKey{servicerPK.PublicKey(), att1PK.PublicKey(), att2PK.PublicKey(), reporterPubKey, getRandomPubKey()} {
+		sessionNodes = append(sessionNodes, types.Validator{
+			Address:   sdk.Address(pk.Address()),
+			PublicKey: pk,
+		})
+	}
+	sameAddress := func(addr sdk.Address) sdk.Address { return addr }
+	// the first attester rotated its consensus key after the session block, so its validator address is no longer the
+	// address of the key that signed the attestation
+	rotatedNodes := append(SessionNodes{}, sessionNodes...)
+	rotatedAddr := getRandomValidatorAddress()
+	rotatedNodes[1] = types.Validator{Address: rotatedAddr, PublicKey: getRandomPubKey()}
+	rotatedAddress := func(addr sdk.Address) sdk.Address {
+		if addr.Equals(sdk.Address(att1PK.PublicKey().Address())) {
+			return rotatedAddr
+		}
+		return addr
+	}
+	tests := []struct {
+		name             string
+		proof            ChallengeProofTimeout
+		maxRelays        int64
+		sessionNodes     SessionNodes
+		reporterAddress  sdk.Address
+		validatorAddress func(sdk.Address) sdk.Address
+		hasError         bool
+	}{
+		{
+			name:            "invalidProof, reporter (self) not in session",
+			proof:           validTimeout,
+			maxRelays:       10000,
+			sessionNodes:    sessionNodes,
+			reporterAddress: sdk.Address([]byte("fake")),
+			hasError:        true,
+		},
+		{
+			name:            "invalidProof, attester not in session",
+			proof:           validTimeout,
+			maxRelays:       10000,
+			sessionNodes:    append(SessionNodes{}, sessionNodes[0], sessionNodes[1], sessionNodes[3], sessionNodes[4]),
+			reporterAddress: sdk.Address(reporterPubKey.Address()),
+			hasError:        true,
+		},
+		{
+			name:            "invalidProof, accused attests",
+			proof:           invalidSelfAttest,
+			maxRelays:       10000,
+			sessionNodes:    sessionNodes,
+			reporterAddress: sdk.Address(reporterPubKey.Address()),
+			hasError:        true,
+		},
+		{
+			name:            "invalidProof, bad attestation signature",
+			proof:           invalidAttestation,
+			maxRelays:       10000,
+			sessionNodes:    sessionNodes,
+			reporterAddress: sdk.Address(reporterPubKey.Address()),
+			hasError:        true,
+		},
+		{
+			name:            "invalidProof, tampered request",
+			proof:           invalidRequest,
+			maxRelays:       10000,
+			sessionNodes:    sessionNodes,
+			reporterAddress: sdk.Address(reporterPubKey.Address()),
+			hasError:        true,
+		},
+		{
+			name:            "invalidProof, tampered deadline",
+			proof:           invalidDeadline,
+			maxRelays:       10000,
+			sessionNodes:    sessionNodes,
+			reporterAddress: sdk.Address(reporterPubKey.Address()),
+			hasError:        true,
+		},
+		{
+			name:            "invalidProof, deadline before the session",
+			proof:           earlyDeadline,
+			maxRelays:       10000,
+			sessionNodes:    sessionNodes,
+			reporterAddress: sdk.Address(reporterPubKey.Address()),
+			hasError:        true,
+		},
+		{
+			name:            "invalidProof, proof overflow",
+			proof:           validTimeout,
+			maxRelays:       0,
+			sessionNodes:    sessionNodes,
+			reporterAddress: sdk.Address(reporterPubKey.Address()),
+			hasError:        true,
+		},
+		{
+			name:            "invalidProof, rotated attester not resolved to its validator",
+			proof:           validTimeout,
+			maxRelays:       10000,
+			sessionNodes:    rotatedNodes,
+			reporterAddress: sdk.Address(reporterPubKey.Address()),
+			hasError:        true,
+		},
+		{
+			name:             "valid proof, rotated attester resolved to its validator",
+			proof:            validTimeout,
+			maxRelays:        10000,
+			sessionNodes:     rotatedNodes,
+			reporterAddress:  sdk.Address(reporterPubKey.Address()),
+			validatorAddress: rotatedAddress,
+			hasError:         false,
+		},
+		{
+			name:            "valid proof",
+			proof:           validTimeout,
+			maxRelays:       10000,
+			sessionNodes:    sessionNodes,
+			reporterAddress: sdk.Address(reporterPubKey.Address()),
+			hasError:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validatorAddress := tt.validatorAddress
+			if validatorAddress == nil {
+				validatorAddress = sameAddress
+			}
+			err := tt.proof.ValidateLocal(tt.maxRelays, 1, []string{getTestSupportedBlockchain()}, 5, tt.sessionNodes, tt.reporterAddress, validatorAddress)
+			assert.Equal(t, err != nil, tt.hasError)
+		})
+	}
+	// a stored timeout cannot be reported a second time
+	validTimeout.Handle()
+	assert.NotNil(t, validTimeout.ValidateLocal(10000, 1, []string{getTestSupportedBlockchain()}, 5, sessionNodes, sdk.Address(reporterPubKey.Address()), sameAddress))
+	// the evidence of the session cannot accuse another servicer
+	otherServicer := validTimeout
+	otherServicer.Request.ServicerPubKey = att1PK.PublicKey().RawString()
+	err := otherServicer.ValidateLocal(10000, 1, []string{getTestSupportedBlockchain()}, 5, sessionNodes, sdk.Address(reporterPubKey.Address()), sameAddress)
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(CodeMultipleTimeoutServicersError), err.Code())
+	ClearEvidence()
+}
+
+func TestChallengeProofTimeout_SessionHeader(t *testing.T) {
+	c, _, _, _, _ := NewValidTimeoutProof(t)
+	assert.Equal(t, c.SessionHeader(), c.Request.SessionHeader())
+	assert.Equal(t, c.EvidenceType(), TimeoutEvidence)
+	assert.Equal(t, KeyForEvidenceByProof(c.SessionHeader(), c), KeyForEvidence(c.SessionHeader(), TimeoutEvidence))
+}
@@ -12,6 +12,7 @@ const (
 	QueryRelay                = "relay"
 	QueryDispatch             = "dispatch"
 	QueryChallenge            = "challenge"
+	QueryTimeout              = "timeout"
 	QueryParameters           = "parameters"
 )
 
@@ -23,6 +24,10 @@ type QueryChallengeParams struct {
 	Challenge ChallengeProofInvalidData `json:"challengeProof"`
 }
 
+type QueryTimeoutParams struct {
+	Timeout ChallengeProofTimeout `json:"timeoutProof"`
+}
+
 type QueryDispatchParams struct {
 	SessionHeader `json:"header"`
 }
@@ -5,18 +5,22 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	appexported "github.com/pokt-network/pocket-core/x/apps/exported"
 	nodeexported "github.com/pokt-network/pocket-core/x/nodes/exported"
 	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
 	"io/ioutil"
-	"math"
 	"net/http"
 	"strings"
 )
 
 const DEFAULTHTTPMETHOD = "POST"
 
+// the relays each session node may serve: the max relays of the chain split between the session nodes, truncated
+// (the chain max relays are already rounded up from the weight of the chain by GetChainMaxRelays)
+func SessionNodeMaxRelays(chainMaxRelays int64, sessionNodeCount int) int64 {
+	return int64(float64(chainMaxRelays) / float64(sessionNodeCount))
+}
+
 // a read / write API request from a hosted (non native) blockchain
 type Relay struct {
 	Payload Payload    `json:"payload"` // the data payload of the request
@@ -24,8 +28,10 @@ type Relay struct {
 	Proof   RelayProof `json:"proof"`   // the authentication scheme needed for work
 }
 
+// validate the relay for the servicer against the session of the application in the session snapshot, so the session
+// nodes and the max relays match the ones the claim is validated against
 func (r *Relay) Validate(ctx sdk.Ctx, node nodeexported.ValidatorI, hb HostedBlockchains, sessionBlockHeight int64,
-	sessionNodeCount int, allNodes []nodeexported.ValidatorI, app appexported.ApplicationI) sdk.Error {
+	snapshot SessionSnapshot, app SessionApp) sdk.Error {
 	// validate payload
 	if err := r.Payload.Validate(); err != nil {
 		return NewEmptyPayloadDataError(ModuleName)
@@ -53,39 +59,28 @@ func (r *Relay) Validate(ctx sdk.Ctx, node nodeexported.ValidatorI, hb HostedBlo
 	if !IsUniqueProof(evidenceHeader, r.Proof) {
 		return NewDuplicateProofError(ModuleName)
 	}
-	// validate not over service
-	if totalRelays >= int64(math.Ceil(float64(app.GetMaxRelays().Int64())/float64(len(app.GetChains())))/(float64(sessionNodeCount))) {
+	// validate not over service, the max relays of the chain are split between the session nodes
+	sessionNodeCount := int(app.SessionNodeCount)
+	if totalRelays >= SessionNodeMaxRelays(app.MaxRelays.Int64(), sessionNodeCount) {
 		return NewOverServiceError(ModuleName)
 	}
 	// validate the Proof
-	if err := r.Proof.ValidateLocal(app.GetChains(), sessionNodeCount, sessionBlockHeight, node.GetPublicKey().RawString()); err != nil {
+	if err := r.Proof.ValidateLocal([]string{snapshot.Chain}, sessionNodeCount, sessionBlockHeight, node.GetPublicKey().RawString()); err != nil {
 		return err
 	}
-	// get the sessionContext
-	sessionContext, er := ctx.PrevCtx(sessionBlockHeight)
-	if er != nil {
-		return sdk.ErrInternal(er.Error())
-	}
 	// generate the header
 	header := SessionHeader{
-		ApplicationPubKey:  app.GetPublicKey().RawString(),
+		ApplicationPubKey:  app.PublicKey,
 		Chain:              r.Proof.Blockchain,
 		SessionBlockHeight: sessionBlockHeight,
 	}
-	// check cache
-	session, found := GetSession(header)
-	// if not found generate the session
-	if !found {
-		var err sdk.Error
-		session, err = NewSession(header, BlockHash(sessionContext), allNodes, sessionNodeCount)
-		if err != nil {
-			return err
-		}
-		// add to cache
-		SetSession(session)
+	// retrieve the session from the snapshot
+	session, err := snapshot.Session(header)
+	if err != nil {
+		return err
 	}
 	// validate the session
-	err := session.Validate(ctx, node, app, sessionNodeCount)
+	err = session.Validate(ctx, node, app)
 	if err != nil {
 		return err
 	}
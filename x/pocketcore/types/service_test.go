@@ -2,17 +2,31 @@ package types
 
 import (
 	"encoding/hex"
+	appexported "github.com/pokt-network/pocket-core/x/apps/exported"
 	appsType "github.com/pokt-network/pocket-core/x/apps/types"
 	"github.com/pokt-network/pocket-core/x/nodes/exported"
 	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/h2non/gock.v1"
+	"math"
 	"reflect"
 	"testing"
 	"time"
 )
 
+func TestSessionNodeMaxRelays(t *testing.T) {
+	app := appsType.Application{MaxRelays: sdk.NewInt(1000), Chains: []string{"0001", "0002", "0003"}}
+	// without weights the threshold is ceil(maxRelays / chains) / nodes
+	for nodes := 1; nodes <= 7; nodes++ {
+		want := int64(math.Ceil(float64(1000)/float64(3)) / float64(nodes))
+		assert.Equal(t, want, SessionNodeMaxRelays(app.GetChainMaxRelays("0001").Int64(), nodes))
+	}
+	// the weight of the chain is applied before the split: ceil(1000 * 2 / 3) / 5
+	app.ChainWeights = []appsType.ChainWeight{{Chain: "0001", Weight: 2}, {Chain: "0002", Weight: 1}}
+	assert.Equal(t, int64(133), SessionNodeMaxRelays(app.GetChainMaxRelays("0001").Int64(), 5))
+}
+
 func TestRelay_Validate(t *testing.T) { // TODO add overservice, and not unique relay here
 	clientPrivateKey := GetRandomPrivateKey()
 	clientPubKey := clientPrivateKey.PublicKey().RawString()
@@ -188,8 +202,11 @@ func TestRelay_Validate(t *testing.T) { // TODO add overservice, and not unique
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.relay.Validate(newContext(t, false).WithAppVersion("0.0.0"), tt.node,
-				tt.hb, 1, 5, tt.allNodes, tt.app) != nil, tt.hasError)
+			ctx := newContext(t, false).WithAppVersion("0.0.0")
+			snapshot := NewSessionSnapshot(1, ethereum, BlockHash(ctx), tt.allNodes, []appexported.ApplicationI{tt.app}, 5, nil, false)
+			// an application without enough nodes for the chain has no session
+			sessionApp, err := snapshot.App(tt.app.PublicKey.RawString())
+			assert.Equal(t, err != nil || tt.relay.Validate(ctx, tt.node, tt.hb, 1, snapshot, sessionApp) != nil, tt.hasError)
 		})
 		ClearSessionCache()
 	}
@@ -1,12 +1,14 @@
 package types
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	appexported "github.com/pokt-network/pocket-core/x/apps/exported"
 	nodeexported "github.com/pokt-network/pocket-core/x/nodes/exported"
 	sdk "github.com/pokt-network/posmint/types"
+	"math/big"
 	"sort"
 )
 
@@ -17,15 +19,20 @@ type Session struct {
 	SessionNodes  `json:"nodes"`
 }
 
-// create a new session from seed data
-func NewSession(sessionHeader SessionHeader, blockHash string, allActiveNodes []nodeexported.ValidatorI, sessionNodesCount int) (Session, sdk.Error) {
+// create a new session from seed data, the excluded nodes of the application are only selected when no other node is left
+func NewSession(sessionHeader SessionHeader, blockHash string, allActiveNodes []nodeexported.ValidatorI, sessionNodesCount int, stakeWeighted bool, excludedNodes []sdk.Address) (Session, sdk.Error) {
 	// first generate session key
 	sessionKey, err := NewSessionKey(sessionHeader.ApplicationPubKey, sessionHeader.Chain, blockHash)
 	if err != nil {
 		return Session{}, err
 	}
 	// then generate the service nodes for that session
-	sessionNodes, err := NewSessionNodes(sessionHeader.Chain, sessionKey, allActiveNodes, sessionNodesCount)
+	var sessionNodes SessionNodes
+	if stakeWeighted {
+		sessionNodes, err = NewStakeWeightedSessionNodes(sessionHeader.Chain, sessionKey, allActiveNodes, sessionNodesCount, excludedNodes)
+	} else {
+		sessionNodes, err = NewSessionNodes(sessionHeader.Chain, sessionKey, allActiveNodes, sessionNodesCount, excludedNodes)
+	}
 	if err != nil {
 		return Session{}, err
 	}
@@ -37,7 +44,124 @@ func NewSession(sessionHeader SessionHeader, blockHash string, allActiveNodes []
 	}, nil
 }
 
-func (s Session) Validate(ctx sdk.Ctx, node nodeexported.ValidatorI, app appexported.ApplicationI, sessionNodeCount int) sdk.Error {
+// the world state needed to validate the sessions of a supported chain, persisted once per session block: only the
+// sessions of the applications staked for the chain and the nodes selected for them are kept
+type SessionSnapshot struct {
+	SessionBlockHeight int64        `json:"session_block_height"`
+	Chain              string       `json:"chain"`
+	BlockHash          string       `json:"block_hash"`
+	Nodes              SessionNodes `json:"nodes"` // the nodes selected for at least one session of the chain
+	Apps               []SessionApp `json:"apps"`  // the sessions of the applications staked for the chain
+}
+
+// the session of an application staked for the chain at the session block
+type SessionApp struct {
+	PublicKey        string        `json:"public_key"`
+	MaxRelays        sdk.Int       `json:"max_relays"`         // the max relays of the application for the chain
+	SessionNodeCount int64         `json:"session_node_count"` // the session node count of the application stake
+	SessionNodes     []sdk.Address `json:"session_nodes"`      // the addresses of the nodes selected for the session
+}
+
+// create the session snapshot of a chain, the session of every application is generated with its session node count
+// and excluded nodes, an application without enough nodes staked for the chain gets no session
+func NewSessionSnapshot(sessionBlockHeight int64, chain, blockHash string, allNodes []nodeexported.ValidatorI, apps []appexported.ApplicationI,
+	sessionNodeCount int64, sessionNodeCountTiers SessionNodeCountTiers, stakeWeighted bool) SessionSnapshot {
+	snapshot := SessionSnapshot{
+		SessionBlockHeight: sessionBlockHeight,
+		Chain:              chain,
+		BlockHash:          blockHash,
+	}
+	selected := make(map[string]struct{})
+	for _, app := range apps {
+		header := SessionHeader{
+			ApplicationPubKey:  app.GetPublicKey().RawString(),
+			Chain:              chain,
+			SessionBlockHeight: sessionBlockHeight,
+		}
+		count := sessionNodeCountTiers.SessionNodeCount(sessionNodeCount, app.GetTokens())
+		session, err := NewSession(header, blockHash, allNodes, int(count), stakeWeighted, app.GetExcludedNodes())
+		if err != nil {
+			continue
+		}
+		sessionNodes := make([]sdk.Address, len(session.SessionNodes))
+		for i, node := range session.SessionNodes {
+			sessionNodes[i] = node.GetAddress()
+			selected[node.GetAddress().String()] = struct{}{}
+		}
+		snapshot.Apps = append(snapshot.Apps, SessionApp{
+			PublicKey:        header.ApplicationPubKey,
+			MaxRelays:        app.GetChainMaxRelays(chain),
+			SessionNodeCount: count,
+			SessionNodes:     sessionNodes,
+		})
+	}
+	// keep the selected nodes in the order of the staked set
+	for _, node := range allNodes {
+		if _, found := selected[node.GetAddress().String()]; found {
+			snapshot.Nodes = append(snapshot.Nodes, node)
+		}
+	}
+	return snapshot
+}
+
+// the node selected for a session of the chain at the session block
+func (ss SessionSnapshot) Node(address sdk.Address) (nodeexported.ValidatorI, sdk.Error) {
+	for _, node := range ss.Nodes {
+		if node.GetAddress().Equals(address) {
+			return node, nil
+		}
+	}
+	return nil, NewNodeNotFoundErr(ModuleName)
+}
+
+// the session of the application staked for the chain at the session block
+func (ss SessionSnapshot) App(appPubKey string) (SessionApp, sdk.Error) {
+	for _, app := range ss.Apps {
+		if app.PublicKey == appPubKey {
+			return app, nil
+		}
+	}
+	return SessionApp{}, NewAppNotFoundError(ModuleName)
+}
+
+// retrieve the session of the application from the cache or the snapshot
+func (ss SessionSnapshot) Session(header SessionHeader) (Session, sdk.Error) {
+	// the snapshot only holds the sessions of its own chain and height
+	if header.Chain != ss.Chain || header.SessionBlockHeight != ss.SessionBlockHeight {
+		return Session{}, NewInvalidSessionError(ModuleName)
+	}
+	// check cache
+	session, found := GetSession(header)
+	if found {
+		return session, nil
+	}
+	// if not found build the session from the snapshot
+	app, err := ss.App(header.ApplicationPubKey)
+	if err != nil {
+		return Session{}, err
+	}
+	sessionKey, err := NewSessionKey(header.ApplicationPubKey, header.Chain, ss.BlockHash)
+	if err != nil {
+		return Session{}, err
+	}
+	sessionNodes := make(SessionNodes, len(app.SessionNodes))
+	for i, addr := range app.SessionNodes {
+		sessionNodes[i], err = ss.Node(addr)
+		if err != nil {
+			return Session{}, err
+		}
+	}
+	session = Session{
+		SessionHeader: header,
+		SessionKey:    sessionKey,
+		SessionNodes:  sessionNodes,
+	}
+	// add to cache
+	SetSession(session)
+	return session, nil
+}
+
+func (s Session) Validate(ctx sdk.Ctx, node nodeexported.ValidatorI, app SessionApp) sdk.Error {
 	// validate chain
 	if len(s.Chain) == 0 {
 		return NewEmptyNonNativeChainError(ModuleName)
@@ -51,23 +175,11 @@ func (s Session) Validate(ctx sdk.Ctx, node nodeexported.ValidatorI, app appexpo
 		return err
 	}
 	// validate app corresponds to appPubKey
-	if app.GetPublicKey().RawString() != s.ApplicationPubKey {
+	if app.PublicKey != s.ApplicationPubKey {
 		return NewInvalidAppPubKeyError(ModuleName)
 	}
-	// validate app chains
-	chains := app.GetChains()
-	found := false
-	for _, c := range chains {
-		if c == s.Chain {
-			found = true
-			break
-		}
-	}
-	if !found {
-		return NewUnsupportedBlockchainAppError(ModuleName)
-	}
 	// validate sessionNodes
-	err := s.SessionNodes.Validate(sessionNodeCount)
+	err := s.SessionNodes.Validate(int(app.SessionNodeCount))
 	if err != nil {
 		return err
 	}
@@ -82,7 +194,7 @@ func (s Session) Validate(ctx sdk.Ctx, node nodeexported.ValidatorI, app appexpo
 type SessionNodes []nodeexported.ValidatorI
 
 // generates nodes for the session
-func NewSessionNodes(chain string, sessionKey SessionKey, allNodes []nodeexported.ValidatorI, sessionNodesCount int) (SessionNodes, sdk.Error) {
+func NewSessionNodes(chain string, sessionKey SessionKey, allNodes []nodeexported.ValidatorI, sessionNodesCount int, excludedNodes []sdk.Address) (SessionNodes, sdk.Error) {
 	// validate chain
 	if len(chain) == 0 {
 		return nil, NewEmptyNonNativeChainError(ModuleName)
@@ -107,10 +219,98 @@ func NewSessionNodes(chain string, sessionKey SessionKey, allNodes []nodeexporte
 	}
 	// sort the nodes based off of distance
 	sessionNodes = revSort(nodeDistances)
+	// the excluded nodes go after all of the other nodes
+	preferred, excluded := partitionExcluded(sessionNodes, excludedNodes)
+	sessionNodes = append(preferred, excluded...)
 	// return the top 5 nodes
 	return sessionNodes[:sessionNodesCount], nil
 }
 
+// generates nodes for the session by sampling (without replacement) proportional to the staked tokens of each node
+func NewStakeWeightedSessionNodes(chain string, sessionKey SessionKey, allNodes []nodeexported.ValidatorI, sessionNodesCount int, excludedNodes []sdk.Address) (SessionNodes, sdk.Error) {
+	// validate chain
+	if len(chain) == 0 {
+		return nil, NewEmptyNonNativeChainError(ModuleName)
+	}
+	// validate sessionKey
+	if err := sessionKey.Validate(); err != nil {
+		return nil, NewInvalidSessionKeyError(ModuleName, err)
+	}
+	// validate allNodes
+	if len(allNodes) < sessionNodesCount {
+		return nil, NewInsufficientNodesError(ModuleName)
+	}
+	// filter `allNodes` by the HASH(chain)
+	sessionNodes, err := filter(allNodes, chain, sessionNodesCount)
+	if err != nil {
+		return nil, NewFilterNodesError(ModuleName, err)
+	}
+	// the excluded nodes are only sampled when there are not enough other nodes
+	preferred, excluded := partitionExcluded(sessionNodes, excludedNodes)
+	result := weightedSample(preferred, sessionKey, sessionNodesCount)
+	if len(result) < sessionNodesCount {
+		result = append(result, weightedSample(excluded, sessionKey, sessionNodesCount-len(result))...)
+	}
+	return result, nil
+}
+
+// split the nodes into the nodes not excluded by the application and the excluded nodes, keeping their order
+func partitionExcluded(nodes SessionNodes, excludedNodes []sdk.Address) (preferred, excluded SessionNodes) {
+	if len(excludedNodes) == 0 {
+		return nodes, nil
+	}
+	isExcluded := make(map[string]bool, len(excludedNodes))
+	for _, addr := range excludedNodes {
+		isExcluded[addr.String()] = true
+	}
+	preferred = make(SessionNodes, 0, len(nodes))
+	for _, node := range nodes {
+		if isExcluded[node.GetAddress().String()] {
+			excluded = append(excluded, node)
+			continue
+		}
+		preferred = append(preferred, node)
+	}
+	return preferred, excluded
+}
+
+// deterministically select `count` nodes, each draw proportional to the staked tokens of the remaining nodes
+func weightedSample(nodes SessionNodes, sessionKey SessionKey, count int) SessionNodes {
+	// sort the candidates by address so the result does not depend on the order of the input
+	candidates := make(SessionNodes, len(nodes))
+	copy(candidates, nodes)
+	sort.Slice(candidates, func(i, j int) bool {
+		return bytes.Compare(candidates[i].GetAddress(), candidates[j].GetAddress()) < 0
+	})
+	totalStake := sdk.ZeroInt()
+	for _, node := range candidates {
+		totalStake = totalStake.Add(node.GetTokens())
+	}
+	result := make(SessionNodes, 0, count)
+	for draw := 0; draw < count && len(candidates) > 0; draw++ {
+		index := 0
+		if totalStake.IsPositive() {
+			// the seed for each draw is hash(sessionKey + draw)
+			seed := new(big.Int).SetBytes(Hash(append(append([]byte{}, sessionKey...), byte(draw))))
+			target := seed.Mod(seed, totalStake.BigInt())
+			// find the node whose cumulative stake range contains the target
+			cumulative := new(big.Int)
+			for i, node := range candidates {
+				cumulative.Add(cumulative, node.GetTokens().BigInt())
+				if target.Cmp(cumulative) < 0 {
+					index = i
+					break
+				}
+			}
+		}
+		selected := candidates[index]
+		result = append(result, selected)
+		totalStake = totalStake.Sub(selected.GetTokens())
+		candidates = append(candidates[:index], candidates[index+1:]...)
+	}
+	return result
+}
+
 // filter the nodes by non native chain
 func filter(allActiveNodes []nodeexported.ValidatorI, nonNativeChainHash string, sessionNodesCount int) (SessionNodes, error) {
 	var result SessionNodes
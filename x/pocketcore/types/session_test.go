@@ -2,6 +2,9 @@ package types
 
 import (
 	"encoding/hex"
+	"fmt"
+	appexported "github.com/pokt-network/pocket-core/x/apps/exported"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	"github.com/pokt-network/pocket-core/x/nodes/exported"
 	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	"github.com/pokt-network/posmint/crypto"
@@ -252,7 +255,7 @@ func TestNewSessionNodes(t *testing.T) {
 	allNodes[9] = node9
 	allNodes[10] = node10
 	allNodes[11] = node11
-	sessionNodes, err := NewSessionNodes(ethereum, fakeSessionKey, allNodes, 5)
+	sessionNodes, err := NewSessionNodes(ethereum, fakeSessionKey, allNodes, 5, nil)
 	assert.Nil(t, err)
 	assert.Len(t, sessionNodes, 5)
 	assert.NotContains(t, sessionNodes, allNodes[0].(nodesTypes.Validator))
@@ -282,3 +285,222 @@ func TestNewSessionNodes(t *testing.T) {
 	assert.Nil(t, sessionNodes.Validate(5))
 	assert.NotNil(t, SessionNodes(make([]exported.ValidatorI, 5)).Validate(5))
 }
+
+func newWeightedTestNodes(t *testing.T, chain string, stakes []int64) []exported.ValidatorI {
+	nodes := make([]exported.ValidatorI, len(stakes))
+	for i, stake := range stakes {
+		pk := getRandomPubKey()
+		nodes[i] = nodesTypes.Validator{
+			Address:         sdk.Address(pk.Address()),
+			PublicKey:       pk,
+			Status:          2,
+			Chains:          []string{chain},
+			StakedTokens:    sdk.NewInt(stake),
+			DelegatedTokens: sdk.ZeroInt(),
+			DelegatorShares: sdk.ZeroDec(),
+			Commission:      sdk.ZeroDec(),
+		}
+	}
+	return nodes
+}
+
+func TestNewStakeWeightedSessionNodes(t *testing.T) {
+	ethereum := getTestSupportedBlockchain()
+	fakeSessionKey, err := hex.DecodeString("36f028580bb02cc8272a9a020f4200e346e276ae664e45ee80745574e2f5ab80")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	allNodes := newWeightedTestNodes(t, ethereum, []int64{100, 200, 300, 400, 500, 600, 700, 800})
+	// a node that doesn't support the chain is never selected
+	otherChain := newWeightedTestNodes(t, hex.EncodeToString(hash([]byte("other"))), []int64{1000000})
+	allNodes = append(allNodes, otherChain...)
+	sessionNodes, er := NewStakeWeightedSessionNodes(ethereum, fakeSessionKey, allNodes, 5, nil)
+	assert.Nil(t, er)
+	assert.Len(t, sessionNodes, 5)
+	assert.False(t, sessionNodes.Contains(otherChain[0]))
+	// no duplicates
+	seen := make(map[string]bool)
+	for _, n := range sessionNodes {
+		assert.False(t, seen[n.GetAddress().String()])
+		seen[n.GetAddress().String()] = true
+	}
+	// the selection is independent of the order of the input
+	reversed := make([]exported.ValidatorI, len(allNodes))
+	for i, n := range allNodes {
+		reversed[len(allNodes)-1-i] = n
+	}
+	sessionNodes2, er := NewStakeWeightedSessionNodes(ethereum, fakeSessionKey, reversed, 5, nil)
+	assert.Nil(t, er)
+	assert.Equal(t, sessionNodes, sessionNodes2)
+	// insufficient nodes
+	_, er = NewStakeWeightedSessionNodes(ethereum, fakeSessionKey, allNodes[:4], 5, nil)
+	assert.NotNil(t, er)
+}
+
+func TestSessionNodes_ExcludedNodes(t *testing.T) {
+	ethereum := getTestSupportedBlockchain()
+	fakeSessionKey, err := hex.DecodeString("36f028580bb02cc8272a9a020f4200e346e276ae664e45ee80745574e2f5ab80")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	allNodes := newWeightedTestNodes(t, ethereum, []int64{100, 200, 300, 400, 500, 600, 700, 800})
+	generators := map[string]func(excludedNodes []sdk.Address) (SessionNodes, sdk.Error){
+		"xor": func(excludedNodes []sdk.Address) (SessionNodes, sdk.Error) {
+			return NewSessionNodes(ethereum, fakeSessionKey, allNodes, 5, excludedNodes)
+		},
+		"stake weighted": func(excludedNodes []sdk.Address) (SessionNodes, sdk.Error) {
+			return NewStakeWeightedSessionNodes(ethereum, fakeSessionKey, allNodes, 5, excludedNodes)
+		},
+	}
+	for name, generate := range generators {
+		t.Run(name, func(t *testing.T) {
+			sessionNodes, er := generate(nil)
+			assert.Nil(t, er)
+			// excluding two of the selected nodes replaces them
+			excludedNodes := []sdk.Address{sessionNodes[0].GetAddress(), sessionNodes[1].GetAddress()}
+			withExclusions, er := generate(excludedNodes)
+			assert.Nil(t, er)
+			assert.Len(t, withExclusions, 5)
+			assert.False(t, withExclusions.Contains(sessionNodes[0]))
+			assert.False(t, withExclusions.Contains(sessionNodes[1]))
+			// the selection is deterministic
+			again, er := generate(excludedNodes)
+			assert.Nil(t, er)
+			assert.Equal(t, withExclusions, again)
+			// the excluded nodes are selected last when not enough other nodes are left
+			excludedNodes = make([]sdk.Address, 0)
+			for _, n := range allNodes[:6] {
+				excludedNodes = append(excludedNodes, n.GetAddress())
+			}
+			withExclusions, er = generate(excludedNodes)
+			assert.Nil(t, er)
+			assert.Len(t, withExclusions, 5)
+			assert.True(t, withExclusions.Contains(allNodes[6]))
+			assert.True(t, withExclusions.Contains(allNodes[7]))
+		})
+	}
+}
+
+func TestStakeWeightedSessionNodes_Distribution(t *testing.T) {
+	ethereum := getTestSupportedBlockchain()
+	stakes := []int64{1000, 2000, 3000, 4000}
+	allNodes := newWeightedTestNodes(t, ethereum, stakes)
+	totalStake := int64(0)
+	for _, s := range stakes {
+		totalStake += s
+	}
+	draws := 20000
+	counts := make(map[string]int)
+	for i := 0; i < draws; i++ {
+		sessionKey := SessionKey(hash([]byte(fmt.Sprintf("session-%d", i))))
+		sessionNodes, err := NewStakeWeightedSessionNodes(ethereum, sessionKey, allNodes, 1, nil)
+		assert.Nil(t, err)
+		counts[sessionNodes[0].GetAddress().String()]++
+	}
+	// the selection frequency is proportional to the stake
+	for i, n := range allNodes {
+		expected := float64(stakes[i]) / float64(totalStake)
+		actual := float64(counts[n.GetAddress().String()]) / float64(draws)
+		assert.InDelta(t, expected, actual, 0.02)
+	}
+	// sampling without replacement still favors the larger stakes
+	counts = make(map[string]int)
+	for i := 0; i < draws; i++ {
+		sessionKey := SessionKey(hash([]byte(fmt.Sprintf("session-%d", i))))
+		sessionNodes, err := NewStakeWeightedSessionNodes(ethereum, sessionKey, allNodes, 2, nil)
+		assert.Nil(t, err)
+		for _, n := range sessionNodes {
+			counts[n.GetAddress().String()]++
+		}
+	}
+	for i := 1; i < len(allNodes); i++ {
+		assert.True(t, counts[allNodes[i].GetAddress().String()] > counts[allNodes[i-1].GetAddress().String()])
+	}
+}
+
+func TestSessionSnapshot_Session(t *testing.T) {
+	InitCacheTest()
+	ethereum := getTestSupportedBlockchain()
+	allNodes := newWeightedTestNodes(t, ethereum, []int64{100, 200, 300, 400, 500, 600})
+	appPubKey := getRandomPubKey()
+	app := appsTypes.Application{Address: sdk.Address(appPubKey.Address()), PublicKey: appPubKey, Chains: []string{ethereum}, StakedTokens: sdk.NewInt(1000), MaxRelays: sdk.NewInt(1000)}
+	blockHash := hex.EncodeToString(hash([]byte("fake")))
+	snapshot := NewSessionSnapshot(1, ethereum, blockHash, allNodes, []appexported.ApplicationI{app}, 5, nil, false)
+	header := SessionHeader{
+		ApplicationPubKey:  appPubKey.RawString(),
+		Chain:              ethereum,
+		SessionBlockHeight: 1,
+	}
+	expected, err := NewSession(header, blockHash, allNodes, 5, false, nil)
+	assert.Nil(t, err)
+	// only the session nodes are kept in the snapshot
+	assert.Len(t, snapshot.Nodes, 5)
+	session, err := snapshot.Session(header)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, session)
+	// the regenerated session is cached
+	cached, found := GetSession(header)
+	assert.True(t, found)
+	assert.Equal(t, expected, cached)
+	// the snapshot can't regenerate sessions of other heights, chains or applications
+	wrongHeight := header
+	wrongHeight.SessionBlockHeight = 2
+	_, err = snapshot.Session(wrongHeight)
+	assert.NotNil(t, err)
+	wrongChain := header
+	wrongChain.Chain = hex.EncodeToString(hash([]byte("other")))
+	_, err = snapshot.Session(wrongChain)
+	assert.NotNil(t, err)
+	wrongApp := header
+	wrongApp.ApplicationPubKey = getRandomPubKey().RawString()
+	_, err = snapshot.Session(wrongApp)
+	assert.Equal(t, NewAppNotFoundError(ModuleName), err)
+	ClearSessionCache()
+}
+
+func TestNewSessionSnapshot_SessionNodeCount(t *testing.T) {
+	ethereum := getTestSupportedBlockchain()
+	allNodes := newWeightedTestNodes(t, ethereum, []int64{100, 200, 300, 400, 500, 600, 700, 800, 900, 1000, 1100, 1200})
+	tiers := SessionNodeCountTiers{
+		{MinStake: sdk.NewInt(1000), SessionNodeCount: 10},
+		{MinStake: sdk.NewInt(100000), SessionNodeCount: 20},
+	}
+	var apps []appexported.ApplicationI
+	for _, stake := range []int64{999, 1000, 100000} {
+		pk := getRandomPubKey()
+		apps = append(apps, appsTypes.Application{Address: sdk.Address(pk.Address()), PublicKey: pk, Chains: []string{ethereum}, StakedTokens: sdk.NewInt(stake), MaxRelays: sdk.NewInt(1000)})
+	}
+	snapshot := NewSessionSnapshot(1, ethereum, hex.EncodeToString(hash([]byte("fake"))), allNodes, apps, 5, tiers, false)
+	// the application of the highest tier has not enough nodes staked for the chain and gets no session
+	assert.Len(t, snapshot.Apps, 2)
+	assert.Equal(t, int64(5), snapshot.Apps[0].SessionNodeCount)
+	assert.Len(t, snapshot.Apps[0].SessionNodes, 5)
+	assert.Equal(t, int64(10), snapshot.Apps[1].SessionNodeCount)
+	assert.Len(t, snapshot.Apps[1].SessionNodes, 10)
+	_, err := snapshot.App(apps[2].GetPublicKey().RawString())
+	assert.Equal(t, NewAppNotFoundError(ModuleName), err)
+	// without tiers every application uses the session node count
+	snapshot = NewSessionSnapshot(1, ethereum, hex.EncodeToString(hash([]byte("fake"))), allNodes, apps, 5, nil, false)
+	assert.Len(t, snapshot.Apps, 3)
+	for _, app := range snapshot.Apps {
+		assert.Equal(t, int64(5), app.SessionNodeCount)
+	}
+}
+
+func TestSessionSnapshot_NodeAndApp(t *testing.T) {
+	nodePubKey, appPubKey := getRandomPubKey(), getRandomPubKey()
+	snapshot := SessionSnapshot{
+		Nodes: SessionNodes{nodesTypes.Validator{Address: sdk.Address(nodePubKey.Address()), PublicKey: nodePubKey}},
+		Apps:  []SessionApp{{PublicKey: appPubKey.RawString()}},
+	}
+	node, err := snapshot.Node(sdk.Address(nodePubKey.Address()))
+	assert.Nil(t, err)
+	assert.Equal(t, sdk.Address(nodePubKey.Address()), node.GetAddress())
+	_, err = snapshot.Node(sdk.Address(appPubKey.Address()))
+	assert.Equal(t, NewNodeNotFoundErr(ModuleName), err)
+	app, err := snapshot.App(appPubKey.RawString())
+	assert.Nil(t, err)
+	assert.Equal(t, appPubKey.RawString(), app.PublicKey)
+	_, err = snapshot.App(nodePubKey.RawString())
+	assert.Equal(t, NewAppNotFoundError(ModuleName), err)
+}